@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// AccountStatus is the status of an account.
+type AccountStatus string
+
+// The statuses of an account. Closing an account is permanent.
+const (
+	AccountStatusActive    AccountStatus = "active"
+	AccountStatusSuspended AccountStatus = "suspended"
+	AccountStatusClosed    AccountStatus = "closed"
+)
+
+// AccountsService provides access to the Accounts resource of the Twilio API,
+// which is used to manage an account and its subaccounts.
+type AccountsService struct {
+	client *Client
+}
+
+// AccountListParams are the filters used when listing accounts. All fields
+// are optional.
+type AccountListParams struct {
+	// Only show accounts with this friendly name.
+	FriendlyName string
+
+	// Only show accounts in this status.
+	Status AccountStatus
+
+	ListOptions
+}
+
+func (p *AccountListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "Status", string(p.Status))
+
+	return v
+}
+
+// AccountUpdateParams are the parameters used to update an account.
+type AccountUpdateParams struct {
+	// The new friendly name of the account.
+	FriendlyName string
+
+	// The new status of the account. Closing an account is permanent.
+	Status AccountStatus
+}
+
+func (p *AccountUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "Status", string(p.Status))
+
+	return v
+}
+
+// Get fetches the account with the given SID. This can be the account of the
+// client, or one of its subaccounts.
+func (s *AccountsService) Get(ctx context.Context, sid string) (*Account, error) {
+	sc, err := s.client.Subaccount(sid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	acct := &Account{}
+
+	if err = sc.getJSON(ctx, "", nil, acct); err != nil {
+		return nil, err
+	}
+
+	return acct, nil
+}
+
+// List returns an iterator over the account of the client and its
+// subaccounts, matching the filters in params. The params value may be nil to
+// list all accounts.
+func (s *AccountsService) List(params *AccountListParams) *Iterator[Account] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newURLIterator[Account](s.client, s.client.BaseURL+".json", "accounts", params.values(), opts)
+}
+
+// Create creates a new subaccount with the given friendly name. If
+// friendlyName is empty, Twilio names the subaccount after its creation time.
+func (s *AccountsService) Create(ctx context.Context, friendlyName string) (*Account, error) {
+	v := url.Values{}
+	setString(v, "FriendlyName", friendlyName)
+
+	req, err := newURLRequest(ctx, s.client, "POST", s.client.BaseURL+".json", strings.NewReader(v.Encode()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	acct := &Account{}
+
+	if err = s.client.do(req, acct); err != nil {
+		return nil, err
+	}
+
+	return acct, nil
+}
+
+// Update modifies the account with the given SID. This is used to rename
+// subaccounts, as well as to suspend, close, or reactivate them.
+func (s *AccountsService) Update(ctx context.Context, sid string, params *AccountUpdateParams) (*Account, error) {
+	if params == nil {
+		return nil, errors.New("*AccountUpdateParams cannot be nil")
+	}
+
+	sc, err := s.client.Subaccount(sid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	acct := &Account{}
+
+	if err = sc.post(ctx, "", params.values(), acct); err != nil {
+		return nil, err
+	}
+
+	return acct, nil
+}
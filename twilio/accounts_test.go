@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// accountsClient points the client at the Accounts resource, as the BaseURL
+// of the test client has no "/Accounts" suffix.
+func accountsClient(c *Client) *Client {
+	c.BaseURL += "/Accounts"
+	return c
+}
+
+func TestAccountsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Account]{
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Get(ctx, "AC2")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/Accounts/AC2.json", body: `{"sid": "AC2", "owner_account_sid": "x", "status": "active"}`},
+			},
+			out:  &Account{SID: "AC2", OwnerAccountSID: "x", Status: AccountStatusActive},
+			desc: `the account should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Get(ctx, "AC9")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/Accounts/AC9.json", status: http.StatusNotFound, body: notFound},
+			},
+			err:  true,
+			desc: `a missing account should return an error`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestAccountsService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Account]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Account, error) {
+				return collect(ctx, accountsClient(c).Accounts.List(&AccountListParams{Status: AccountStatusSuspended}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/Accounts.json", form: form("Status", "suspended"),
+					body: `{"accounts": [{"sid": "AC2"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Account{{SID: "AC2"}},
+			desc: `accounts should be filtered by status`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Account, error) {
+				return collect(ctx, accountsClient(c).Accounts.List(nil))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/Accounts.json", body: `{"accounts": [{"sid": "x"}, {"sid": "AC2"}], "next_page_uri": null}`},
+			},
+			out:  []Account{{SID: "x"}, {SID: "AC2"}},
+			desc: `nil params should list all accounts`,
+		},
+	})
+}
+
+func TestAccountsService_Create(t *testing.T) {
+	testService(t, []serviceTest[*Account]{
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Create(ctx, "team-db")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/Accounts.json", form: form("FriendlyName", "team-db"),
+					status: http.StatusCreated, body: `{"sid": "AC3", "friendly_name": "team-db"}`,
+				},
+			},
+			out:  &Account{SID: "AC3", FriendlyName: "team-db"},
+			desc: `the subaccount should be created with the friendly name`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Create(ctx, "")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/Accounts.json", status: http.StatusCreated, body: `{"sid": "AC3"}`},
+			},
+			out:  &Account{SID: "AC3"},
+			desc: `an empty friendly name should not be sent`,
+		},
+	})
+}
+
+func TestAccountsService_Update(t *testing.T) {
+	testService(t, []serviceTest[*Account]{
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Update(ctx, "AC2", &AccountUpdateParams{Status: AccountStatusSuspended})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/Accounts/AC2.json", form: form("Status", "suspended"),
+					body: `{"sid": "AC2", "status": "suspended"}`,
+				},
+			},
+			out:  &Account{SID: "AC2", Status: AccountStatusSuspended},
+			desc: `the account should be suspended`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Update(ctx, "AC2", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Account, error) {
+				return accountsClient(c).Accounts.Update(ctx, "", &AccountUpdateParams{Status: AccountStatusClosed})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestClient_Subaccount(t *testing.T) {
+	testService(t, []serviceTest[*Call]{
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				sc, err := accountsClient(c).Subaccount("AC2")
+
+				if err != nil {
+					return nil, err
+				}
+
+				return sc.Calls.Get(ctx, "CA1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/Accounts/AC2/Calls/CA1.json", user: "x", body: `{"sid": "CA1", "account_sid": "AC2"}`},
+			},
+			out:  &Call{SID: "CA1", AccountSID: "AC2"},
+			desc: `a subaccount client should use the parent's credentials, but the subaccount's resources`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				_, err := c.Subaccount("")
+				return nil, err
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// AddressesService provides access to the Addresses resource of the Twilio
+// API, which is used to register the physical addresses that some countries
+// require for phone numbers.
+type AddressesService struct {
+	client *Client
+}
+
+// AddressParams are the parameters used to create or update an address. When
+// creating an address, all fields except FriendlyName, StreetSecondary,
+// EmergencyEnabled, and AutoCorrectAddress are required. When updating an
+// address, only the fields that are set are changed.
+type AddressParams struct {
+	// A human readable description of the address, up to 64 characters.
+	FriendlyName string
+
+	// The name of the customer the address belongs to.
+	CustomerName string
+
+	// The number and street of the address.
+	Street string
+
+	// The second line of the street, such as a suite or floor.
+	StreetSecondary string
+
+	// The city of the address.
+	City string
+
+	// The state or region of the address.
+	Region string
+
+	// The postal code of the address.
+	PostalCode string
+
+	// The ISO country code of the address. This can't be changed once the
+	// address has been created, so it must be empty when updating.
+	IsoCountry string
+
+	// Whether to enable emergency calling on the address.
+	EmergencyEnabled *bool
+
+	// Whether Twilio may correct the address, to improve the chance it can be
+	// validated. Defaults to true.
+	AutoCorrectAddress *bool
+}
+
+func (p *AddressParams) validate() error {
+	if p == nil {
+		return errors.New("*AddressParams cannot be nil")
+	}
+
+	required := []struct{ name, value string }{
+		{"CustomerName", p.CustomerName},
+		{"Street", p.Street},
+		{"City", p.City},
+		{"Region", p.Region},
+		{"PostalCode", p.PostalCode},
+		{"IsoCountry", p.IsoCountry},
+	}
+
+	for _, r := range required {
+		if len(r.value) == 0 {
+			return errors.New(r.name + " cannot be zero length")
+		}
+	}
+
+	return nil
+}
+
+func (p *AddressParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "CustomerName", p.CustomerName)
+	setString(v, "Street", p.Street)
+	setString(v, "StreetSecondary", p.StreetSecondary)
+	setString(v, "City", p.City)
+	setString(v, "Region", p.Region)
+	setString(v, "PostalCode", p.PostalCode)
+	setString(v, "IsoCountry", p.IsoCountry)
+	setBool(v, "EmergencyEnabled", p.EmergencyEnabled)
+	setBool(v, "AutoCorrectAddress", p.AutoCorrectAddress)
+
+	return v
+}
+
+// AddressListParams are the filters used when listing addresses. All fields
+// are optional.
+type AddressListParams struct {
+	// Only show addresses with this customer name.
+	CustomerName string
+
+	// Only show addresses with this friendly name.
+	FriendlyName string
+
+	// Only show addresses in this country.
+	IsoCountry string
+
+	ListOptions
+}
+
+func (p *AddressListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "CustomerName", p.CustomerName)
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "IsoCountry", p.IsoCountry)
+
+	return v
+}
+
+// Create registers a new address with the account.
+func (s *AddressesService) Create(ctx context.Context, params *AddressParams) (*Address, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	addr := &Address{}
+
+	if err := s.client.post(ctx, "/Addresses", params.values(), addr); err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
+// Get fetches the address with the given SID.
+func (s *AddressesService) Get(ctx context.Context, sid string) (*Address, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	addr := &Address{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Addresses", sid), nil, addr); err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
+// Update modifies the address with the given SID.
+func (s *AddressesService) Update(ctx context.Context, sid string, params *AddressParams) (*Address, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*AddressParams cannot be nil")
+	}
+
+	if len(params.IsoCountry) > 0 {
+		return nil, errors.New("IsoCountry cannot be changed")
+	}
+
+	addr := &Address{}
+
+	if err := s.client.post(ctx, resourcePath("Addresses", sid), params.values(), addr); err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
+// Delete removes the address with the given SID. Twilio refuses to delete an
+// address that phone numbers depend on.
+func (s *AddressesService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("Addresses", sid))
+}
+
+// List returns an iterator over the addresses matching the filters in params.
+// The params value may be nil to list all addresses.
+func (s *AddressesService) List(params *AddressListParams) *Iterator[Address] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Address](s.client, "/Addresses", "addresses", params.values(), opts)
+}
+
+// DependentPhoneNumbers returns an iterator over the phone numbers that depend
+// on the address with the given SID.
+func (s *AddressesService) DependentPhoneNumbers(sid string, opts ListOptions) *Iterator[DependentPhoneNumber] {
+	if len(sid) == 0 {
+		return errIterator[DependentPhoneNumber](errors.New("sid cannot be zero length"))
+	}
+
+	return newIterator[DependentPhoneNumber](
+		s.client, resourcePath("Addresses", sid, "DependentPhoneNumbers"),
+		"dependent_phone_numbers", nil, opts,
+	)
+}
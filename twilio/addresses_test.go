@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAddressesService_Create(t *testing.T) {
+	testService(t, []serviceTest[*Address]{
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Create(ctx, &AddressParams{
+					CustomerName:    "Houston",
+					Street:          "Unter den Linden 1",
+					StreetSecondary: "Etage 4",
+					City:            "Berlin",
+					Region:          "Berlin",
+					PostalCode:      "10117",
+					IsoCountry:      "DE",
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Addresses.json",
+					form: form(
+						"CustomerName", "Houston", "Street", "Unter den Linden 1", "StreetSecondary", "Etage 4",
+						"City", "Berlin", "Region", "Berlin", "PostalCode", "10117", "IsoCountry", "DE",
+					),
+					status: http.StatusCreated, body: `{"sid": "AD1", "street_secondary": "Etage 4", "iso_country": "DE"}`,
+				},
+			},
+			out:  &Address{SID: "AD1", StreetSecondary: "Etage 4", IsoCountry: "DE"},
+			desc: `the address should be created`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Create(ctx, &AddressParams{CustomerName: "Houston"})
+			},
+			err:  true,
+			desc: `missing required fields should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestAddressesService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Address]{
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Get(ctx, "AD1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Addresses/AD1.json", body: `{"sid": "AD1", "city": "Berlin"}`},
+			},
+			out:  &Address{SID: "AD1", City: "Berlin"},
+			desc: `the address should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Get(ctx, "AD9")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Addresses/AD9.json", status: http.StatusNotFound, body: notFound},
+			},
+			err:  true,
+			desc: `a missing address should return an error`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestAddressesService_Update(t *testing.T) {
+	enabled := true
+
+	testService(t, []serviceTest[*Address]{
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Update(ctx, "AD1", &AddressParams{EmergencyEnabled: &enabled})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Addresses/AD1.json", form: form("EmergencyEnabled", "true"),
+					body: `{"sid": "AD1", "emergency_enabled": true}`,
+				},
+			},
+			out:  &Address{SID: "AD1", EmergencyEnabled: true},
+			desc: `only the fields that are set should be sent`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Update(ctx, "AD1", &AddressParams{IsoCountry: "US"})
+			},
+			err:  true,
+			desc: `changing IsoCountry should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Update(ctx, "AD1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Address, error) {
+				return c.Addresses.Update(ctx, "", &AddressParams{EmergencyEnabled: &enabled})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestAddressesService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Addresses.Delete(ctx, "AD1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Addresses/AD1.json", status: http.StatusNoContent},
+			},
+			desc: `the address should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Addresses.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestAddressesService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Address]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Address, error) {
+				return collect(ctx, c.Addresses.List(&AddressListParams{CustomerName: "Houston", IsoCountry: "DE"}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Addresses.json", form: form("CustomerName", "Houston", "IsoCountry", "DE"),
+					body: `{"addresses": [{"sid": "AD1"}, {"sid": "AD2"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Address{{SID: "AD1"}, {SID: "AD2"}},
+			desc: `addresses should be filtered by customer name and country`,
+		},
+	})
+}
+
+func TestAddressesService_DependentPhoneNumbers(t *testing.T) {
+	testService(t, []serviceTest[[]DependentPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) ([]DependentPhoneNumber, error) {
+				return collect(ctx, c.Addresses.DependentPhoneNumbers("AD1", ListOptions{}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Addresses/AD1/DependentPhoneNumbers.json",
+					body: `{"dependent_phone_numbers": [{"sid": "PN1", "phone_number": "+4930123456"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []DependentPhoneNumber{{SID: "PN1", PhoneNumber: "+4930123456"}},
+			desc: `the phone numbers using the address should be listed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]DependentPhoneNumber, error) {
+				return collect(ctx, c.Addresses.DependentPhoneNumbers("", ListOptions{}))
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// PhoneNumberType is the type of phone number to search for.
+type PhoneNumberType string
+
+// The types of phone numbers that can be searched for.
+const (
+	PhoneNumberTypeLocal    PhoneNumberType = "Local"
+	PhoneNumberTypeTollFree PhoneNumberType = "TollFree"
+	PhoneNumberTypeMobile   PhoneNumberType = "Mobile"
+)
+
+// ErrNoAvailablePhoneNumbers is returned by Purchase when no phone numbers
+// match the search filters.
+var ErrNoAvailablePhoneNumbers = errors.New("no available phone numbers match the search filters")
+
+// AvailablePhoneNumbersService provides access to the AvailablePhoneNumbers
+// resource of the Twilio API, which is used to search for phone numbers that
+// can be bought.
+type AvailablePhoneNumbersService struct {
+	client *Client
+}
+
+// AvailablePhoneNumberFilters are the filters used when searching for phone
+// numbers. All fields are optional.
+type AvailablePhoneNumberFilters struct {
+	// Only find numbers in this area code. Only available for US and Canadian
+	// numbers.
+	AreaCode string
+
+	// Only find numbers matching this pattern. The pattern may contain digits,
+	// letters, and * as a wildcard (e.g., "555*HELP").
+	Contains string
+
+	// Only find numbers with, or without, each of these capabilities.
+	SmsEnabled   *bool
+	MmsEnabled   *bool
+	VoiceEnabled *bool
+	FaxEnabled   *bool
+
+	// Only find numbers in this state or province.
+	InRegion string
+
+	// Only find numbers in this postal code.
+	InPostalCode string
+
+	// Only find numbers near this "latitude,longitude" pair. Only available
+	// for US and Canadian numbers.
+	NearLatLong string
+
+	// The distance in miles from NearLatLong to search. Defaults to 25.
+	Distance int
+
+	// The number of phone numbers to return. Twilio returns at most 30.
+	PageSize int
+}
+
+func (f *AvailablePhoneNumberFilters) values() url.Values {
+	v := url.Values{}
+
+	if f == nil {
+		return v
+	}
+
+	setString(v, "AreaCode", f.AreaCode)
+	setString(v, "Contains", f.Contains)
+	setBool(v, "SmsEnabled", f.SmsEnabled)
+	setBool(v, "MmsEnabled", f.MmsEnabled)
+	setBool(v, "VoiceEnabled", f.VoiceEnabled)
+	setBool(v, "FaxEnabled", f.FaxEnabled)
+	setString(v, "InRegion", f.InRegion)
+	setString(v, "InPostalCode", f.InPostalCode)
+	setString(v, "NearLatLong", f.NearLatLong)
+	setInt(v, "Distance", f.Distance)
+	setInt(v, "PageSize", f.PageSize)
+
+	return v
+}
+
+// Search finds phone numbers of the given kind, in the country with the given
+// ISO country code (e.g., "US"), that match the filters. The filters may be
+// nil to find any number.
+func (s *AvailablePhoneNumbersService) Search(ctx context.Context, country string, kind PhoneNumberType, filters *AvailablePhoneNumberFilters) ([]AvailablePhoneNumber, error) {
+	if len(country) == 0 {
+		return nil, errors.New("country cannot be zero length")
+	}
+
+	switch kind {
+	case PhoneNumberTypeLocal, PhoneNumberTypeTollFree, PhoneNumberTypeMobile:
+	default:
+		return nil, fmt.Errorf("unknown PhoneNumberType %q", kind)
+	}
+
+	var page struct {
+		AvailablePhoneNumbers []AvailablePhoneNumber `json:"available_phone_numbers"`
+	}
+
+	resource := resourcePath("AvailablePhoneNumbers", country, string(kind))
+
+	if err := s.client.getJSON(ctx, resource, filters.values(), &page); err != nil {
+		return nil, err
+	}
+
+	return page.AvailablePhoneNumbers, nil
+}
+
+// Purchase searches for phone numbers like Search, and buys the first match
+// through the IncomingPhoneNumbers resource. The config, which may be nil, is
+// used to configure the number as it is bought. If no numbers match,
+// ErrNoAvailablePhoneNumbers is returned.
+func (s *AvailablePhoneNumbersService) Purchase(ctx context.Context, country string, kind PhoneNumberType, filters *AvailablePhoneNumberFilters, config *IncomingPhoneNumberConfig) (*IncomingPhoneNumber, error) {
+	numbers, err := s.Search(ctx, country, kind, filters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(numbers) == 0 {
+		return nil, ErrNoAvailablePhoneNumbers
+	}
+
+	p := IncomingPhoneNumberParams{PhoneNumber: numbers[0].PhoneNumber}
+
+	if config != nil {
+		p.IncomingPhoneNumberConfig = *config
+	}
+
+	return s.client.IncomingPhoneNumbers.Create(ctx, &p)
+}
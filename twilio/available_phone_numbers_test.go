@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const availableLocalNumbers = `{"available_phone_numbers": [
+	{"phone_number": "+14155550001", "region": "CA", "capabilities": {"voice": true, "sms": true, "mms": true}},
+	{"phone_number": "+14155550002", "region": "CA", "capabilities": {"voice": true, "sms": true, "mms": false}}
+]}`
+
+func TestAvailablePhoneNumbersService_Search(t *testing.T) {
+	sms := true
+
+	testService(t, []serviceTest[[]AvailablePhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) ([]AvailablePhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Search(ctx, "US", PhoneNumberTypeLocal, &AvailablePhoneNumberFilters{AreaCode: "415", SmsEnabled: &sms})
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/AvailablePhoneNumbers/US/Local.json", form: form("AreaCode", "415", "SmsEnabled", "true"),
+					body: availableLocalNumbers,
+				},
+			},
+			out: []AvailablePhoneNumber{
+				{PhoneNumber: "+14155550001", Region: "CA", Capabilities: PhoneNumberCapabilities{Voice: true, SMS: true, MMS: true}},
+				{PhoneNumber: "+14155550002", Region: "CA", Capabilities: PhoneNumberCapabilities{Voice: true, SMS: true}},
+			},
+			desc: `the filters should be sent as the query`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]AvailablePhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Search(ctx, "US", PhoneNumberTypeTollFree, nil)
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/AvailablePhoneNumbers/US/TollFree.json", body: `{"available_phone_numbers": []}`},
+			},
+			out:  []AvailablePhoneNumber{},
+			desc: `nil filters should search for any number`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]AvailablePhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Search(ctx, "US", "Premium", nil)
+			},
+			err:  true,
+			desc: `an unknown PhoneNumberType should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]AvailablePhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Search(ctx, "", PhoneNumberTypeLocal, nil)
+			},
+			err:  true,
+			desc: `an empty country should be rejected`,
+		},
+	})
+}
+
+func TestAvailablePhoneNumbersService_Purchase(t *testing.T) {
+	testService(t, []serviceTest[*IncomingPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Purchase(
+					ctx, "US", PhoneNumberTypeLocal, &AvailablePhoneNumberFilters{AreaCode: "415"},
+					&IncomingPhoneNumberConfig{FriendlyName: "rotation-db"},
+				)
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/AvailablePhoneNumbers/US/Local.json", form: form("AreaCode", "415"), body: availableLocalNumbers},
+				{
+					method: "POST", path: "/x/IncomingPhoneNumbers.json", form: form("PhoneNumber", "+14155550001", "FriendlyName", "rotation-db"),
+					status: http.StatusCreated, body: `{"sid": "PN1", "phone_number": "+14155550001", "friendly_name": "rotation-db"}`,
+				},
+			},
+			out:  &IncomingPhoneNumber{SID: "PN1", PhoneNumber: "+14155550001", FriendlyName: "rotation-db"},
+			desc: `the first match should be bought with the config`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.AvailablePhoneNumbers.Purchase(ctx, "US", PhoneNumberTypeLocal, nil, nil)
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/AvailablePhoneNumbers/US/Local.json", body: availableLocalNumbers},
+				{
+					method: "POST", path: "/x/IncomingPhoneNumbers.json", form: form("PhoneNumber", "+14155550001"),
+					status: http.StatusCreated, body: `{"sid": "PN1", "phone_number": "+14155550001"}`,
+				},
+			},
+			out:  &IncomingPhoneNumber{SID: "PN1", PhoneNumber: "+14155550001"},
+			desc: `a nil config should only send the phone number`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				pn, err := c.AvailablePhoneNumbers.Purchase(ctx, "US", PhoneNumberTypeTollFree, nil, nil)
+
+				if err != ErrNoAvailablePhoneNumbers {
+					return pn, fmt.Errorf("err = %v; want ErrNoAvailablePhoneNumbers", err)
+				}
+
+				return pn, nil
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/AvailablePhoneNumbers/US/TollFree.json", body: `{"available_phone_numbers": []}`},
+			},
+			desc: `no matches should return ErrNoAvailablePhoneNumbers`,
+		},
+	})
+}
@@ -0,0 +1,316 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// CallStatus is the status of a call.
+type CallStatus string
+
+// The statuses of a call. A call is queued, then ringing, then in-progress
+// once answered, and ends as completed. It can instead end early as busy,
+// failed, no-answer, or canceled. The initiated status is only sent to status
+// callbacks, when Twilio starts dialing.
+const (
+	CallStatusQueued     CallStatus = "queued"
+	CallStatusInitiated  CallStatus = "initiated"
+	CallStatusRinging    CallStatus = "ringing"
+	CallStatusInProgress CallStatus = "in-progress"
+	CallStatusCompleted  CallStatus = "completed"
+	CallStatusBusy       CallStatus = "busy"
+	CallStatusFailed     CallStatus = "failed"
+	CallStatusNoAnswer   CallStatus = "no-answer"
+	CallStatusCanceled   CallStatus = "canceled"
+)
+
+// Valid returns whether s is a known CallStatus.
+func (s CallStatus) Valid() bool {
+	switch s {
+	case CallStatusQueued, CallStatusInitiated, CallStatusRinging, CallStatusInProgress:
+		return true
+	}
+
+	return s.Terminal()
+}
+
+// Terminal returns whether s is a final status, meaning the call has ended.
+func (s CallStatus) Terminal() bool {
+	switch s {
+	case CallStatusCompleted, CallStatusBusy, CallStatusFailed, CallStatusNoAnswer, CallStatusCanceled:
+		return true
+	}
+
+	return false
+}
+
+// CallsService provides access to the Calls resource of the Twilio API, which
+// is used to place and manage voice calls.
+type CallsService struct {
+	client *Client
+}
+
+// CallParams are the parameters used to place a new outbound call. Exactly
+// one of URL, Twiml, or ApplicationSID must be set to tell Twilio what to do
+// once the call connects.
+type CallParams struct {
+	// The phone number, SIP address, or client identifier to call. Required.
+	To string
+
+	// The phone number or client identifier to use as the caller ID. Required.
+	From string
+
+	// The URL Twilio will request TwiML from when the call connects.
+	URL string
+
+	// The HTTP method Twilio should use when requesting URL. Defaults to POST.
+	Method string
+
+	// Inline TwiML instructions to execute when the call connects.
+	Twiml string
+
+	// The SID of the TwiML Application used to handle the call.
+	ApplicationSID string
+
+	// The URL Twilio should request if requesting URL fails.
+	FallbackURL string
+
+	// The URL Twilio will send call progress events to.
+	StatusCallback string
+
+	// The HTTP method Twilio should use when requesting StatusCallback.
+	StatusCallbackMethod string
+
+	// The call progress events that should be sent to StatusCallback. Can be
+	// any of initiated, ringing, answered, and completed. Defaults to only
+	// completed.
+	StatusCallbackEvent []string
+
+	// Whether to detect if a human, answering machine, or fax has picked up
+	// the call. Can be Enable or DetectMessageEnd.
+	MachineDetection string
+
+	// The number of seconds to attempt machine detection before giving up.
+	MachineDetectionTimeout int
+
+	// The number of seconds to let the call ring before assuming there is no
+	// answer. Zero uses the Twilio default of 60 seconds.
+	Timeout int
+
+	// Whether to record the call.
+	Record bool
+
+	// The URL Twilio will request when the recording is available.
+	RecordingStatusCallback string
+
+	// DTMF digits to play once the call is connected.
+	SendDigits string
+}
+
+func (p *CallParams) validate() error {
+	if p == nil {
+		return errors.New("*CallParams cannot be nil")
+	}
+
+	if len(p.To) == 0 {
+		return errors.New("To cannot be zero length")
+	}
+
+	if len(p.From) == 0 {
+		return errors.New("From cannot be zero length")
+	}
+
+	var n int
+
+	for _, s := range []string{p.URL, p.Twiml, p.ApplicationSID} {
+		if len(s) > 0 {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return errors.New("exactly one of URL, Twiml, or ApplicationSID must be set")
+	}
+
+	return nil
+}
+
+func (p *CallParams) values() url.Values {
+	v := url.Values{}
+
+	v.Set("To", p.To)
+	v.Set("From", p.From)
+
+	setString(v, "Url", p.URL)
+	setString(v, "Method", p.Method)
+	setString(v, "Twiml", p.Twiml)
+	setString(v, "ApplicationSid", p.ApplicationSID)
+	setString(v, "FallbackUrl", p.FallbackURL)
+	setString(v, "StatusCallback", p.StatusCallback)
+	setString(v, "StatusCallbackMethod", p.StatusCallbackMethod)
+
+	for _, e := range p.StatusCallbackEvent {
+		v.Add("StatusCallbackEvent", e)
+	}
+
+	setString(v, "MachineDetection", p.MachineDetection)
+	setInt(v, "MachineDetectionTimeout", p.MachineDetectionTimeout)
+	setInt(v, "Timeout", p.Timeout)
+
+	if p.Record {
+		v.Set("Record", "true")
+	}
+
+	setString(v, "RecordingStatusCallback", p.RecordingStatusCallback)
+	setString(v, "SendDigits", p.SendDigits)
+
+	return v
+}
+
+// CallUpdateParams are the parameters used to modify a live call. Setting URL
+// or Twiml redirects the call to new instructions, while setting Status to
+// canceled or completed ends it.
+type CallUpdateParams struct {
+	// The URL Twilio will request new TwiML from.
+	URL string
+
+	// The HTTP method Twilio should use when requesting URL.
+	Method string
+
+	// Inline TwiML instructions that replace the call's current instructions.
+	Twiml string
+
+	// The new status of the call. CallStatusCanceled ends a queued or
+	// ringing call, and CallStatusCompleted hangs up an in-progress call.
+	Status CallStatus
+
+	// The URL Twilio will send call progress events to.
+	StatusCallback string
+
+	// The HTTP method Twilio should use when requesting StatusCallback.
+	StatusCallbackMethod string
+}
+
+func (p *CallUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "Url", p.URL)
+	setString(v, "Method", p.Method)
+	setString(v, "Twiml", p.Twiml)
+	setString(v, "Status", string(p.Status))
+	setString(v, "StatusCallback", p.StatusCallback)
+	setString(v, "StatusCallbackMethod", p.StatusCallbackMethod)
+
+	return v
+}
+
+// CallListParams are the filters used when listing calls. All fields are
+// optional.
+type CallListParams struct {
+	// Only show calls made to this phone number, SIP address, or client.
+	To string
+
+	// Only show calls made from this phone number, SIP address, or client.
+	From string
+
+	// Only show calls spawned by the call with this SID.
+	ParentCallSID string
+
+	// Only show calls in this status.
+	Status CallStatus
+
+	// Only show calls that started on or after this date.
+	StartedOnOrAfter time.Time
+
+	// Only show calls that started on or before this date.
+	StartedOnOrBefore time.Time
+
+	ListOptions
+}
+
+func (p *CallListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "To", p.To)
+	setString(v, "From", p.From)
+	setString(v, "ParentCallSid", p.ParentCallSID)
+	setString(v, "Status", string(p.Status))
+	setDate(v, "StartTime>", p.StartedOnOrAfter)
+	setDate(v, "StartTime<", p.StartedOnOrBefore)
+
+	return v
+}
+
+// Create places a new outbound call.
+func (s *CallsService) Create(ctx context.Context, params *CallParams) (*Call, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	call := &Call{}
+
+	if err := s.client.post(ctx, "/Calls", params.values(), call); err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// Get fetches the call with the given SID.
+func (s *CallsService) Get(ctx context.Context, sid string) (*Call, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	call := &Call{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Calls", sid), nil, call); err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// Update modifies the live call with the given SID. This can be used to
+// redirect the call to new TwiML or to hang it up.
+func (s *CallsService) Update(ctx context.Context, sid string, params *CallUpdateParams) (*Call, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*CallUpdateParams cannot be nil")
+	}
+
+	call := &Call{}
+
+	if err := s.client.post(ctx, resourcePath("Calls", sid), params.values(), call); err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// List returns an iterator over the calls matching the filters in params. The
+// params value may be nil to list all calls.
+func (s *CallsService) List(params *CallListParams) *Iterator[Call] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Call](s.client, "/Calls", "calls", params.values(), opts)
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCallsService_Create(t *testing.T) {
+	testService(t, []serviceTest[*Call]{
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Create(ctx, &CallParams{
+					To:                  "+15005550001",
+					From:                "+15005550006",
+					Twiml:               "<Response><Hangup/></Response>",
+					StatusCallbackEvent: []string{"answered", "completed"},
+					MachineDetection:    "Enable",
+					Timeout:             15,
+					Record:              true,
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Calls.json",
+					form: form(
+						"To", "+15005550001", "From", "+15005550006", "Twiml", "<Response><Hangup/></Response>",
+						"StatusCallbackEvent", "answered", "StatusCallbackEvent", "completed",
+						"MachineDetection", "Enable", "Timeout", "15", "Record", "true",
+					),
+					status: http.StatusCreated, body: `{"sid": "CA1", "to": "+15005550001", "from": "+15005550006", "status": "queued"}`,
+				},
+			},
+			out:  &Call{SID: "CA1", To: "+15005550001", From: "+15005550006", Status: CallStatusQueued},
+			desc: `the call should be placed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Create(ctx, &CallParams{To: "a", From: "b", URL: "c", Twiml: "d"})
+			},
+			err:  true,
+			desc: `setting both URL and Twiml should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Create(ctx, &CallParams{To: "a", From: "b"})
+			},
+			err:  true,
+			desc: `setting none of URL, Twiml, or ApplicationSID should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Create(ctx, &CallParams{From: "b", URL: "c"})
+			},
+			err:  true,
+			desc: `an empty To should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestCallsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Call]{
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Get(ctx, "CA1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Calls/CA1.json", body: `{"sid": "CA1", "status": "in-progress"}`},
+			},
+			out:  &Call{SID: "CA1", Status: CallStatusInProgress},
+			desc: `the call should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Get(ctx, "CA2")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Calls/CA2.json", status: http.StatusNotFound, body: notFound},
+			},
+			err:  true,
+			desc: `a missing call should return an error`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestCallsService_Update(t *testing.T) {
+	testService(t, []serviceTest[*Call]{
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Update(ctx, "CA1", &CallUpdateParams{Status: CallStatusCompleted})
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Calls/CA1.json", form: form("Status", "completed"), body: `{"sid": "CA1", "status": "completed"}`},
+			},
+			out:  &Call{SID: "CA1", Status: CallStatusCompleted},
+			desc: `the call should be hung up`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Update(ctx, "CA1", &CallUpdateParams{URL: "https://example.org/twiml", Method: "GET"})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Calls/CA1.json", form: form("Url", "https://example.org/twiml", "Method", "GET"),
+					body: `{"sid": "CA1", "status": "in-progress"}`,
+				},
+			},
+			out:  &Call{SID: "CA1", Status: CallStatusInProgress},
+			desc: `the call should be redirected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Update(ctx, "CA1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Call, error) {
+				return c.Calls.Update(ctx, "", &CallUpdateParams{Status: CallStatusCompleted})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestCallsService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Call]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Call, error) {
+				return collect(ctx, c.Calls.List(&CallListParams{
+					Status:           CallStatusNoAnswer,
+					StartedOnOrAfter: time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC),
+				}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Calls.json", form: form("Status", "no-answer", "StartTime>", "2017-03-01"),
+					body: `{"calls": [{"sid": "CA1"}, {"sid": "CA2"}], "page": 0, "page_size": 50}`,
+				},
+			},
+			out:  []Call{{SID: "CA1"}, {SID: "CA2"}},
+			desc: `calls should be filtered by status and start date`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Call, error) {
+				return collect(ctx, c.Calls.List(&CallListParams{ListOptions: ListOptions{PageSize: 1, MaxPages: 1}}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Calls.json", form: form("PageSize", "1"),
+					body: `{"calls": [{"sid": "CA1"}], "next_page_uri": "/x/Calls.json?Page=1&PageSize=1"}`,
+				},
+			},
+			out:  []Call{{SID: "CA1"}},
+			desc: `the list options should be applied`,
+		},
+	})
+}
@@ -0,0 +1,504 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ConferenceStatus is the status of a conference.
+type ConferenceStatus string
+
+// The statuses of a conference. A conference is init until enough
+// participants have joined to start it, and completed once it has ended.
+const (
+	ConferenceStatusInit       ConferenceStatus = "init"
+	ConferenceStatusInProgress ConferenceStatus = "in-progress"
+	ConferenceStatusCompleted  ConferenceStatus = "completed"
+)
+
+// ParticipantStatus is the status of a conference participant.
+type ParticipantStatus string
+
+// The statuses of a conference participant.
+const (
+	ParticipantStatusQueued     ParticipantStatus = "queued"
+	ParticipantStatusConnecting ParticipantStatus = "connecting"
+	ParticipantStatusRinging    ParticipantStatus = "ringing"
+	ParticipantStatusConnected  ParticipantStatus = "connected"
+	ParticipantStatusComplete   ParticipantStatus = "complete"
+	ParticipantStatusFailed     ParticipantStatus = "failed"
+)
+
+// ConferencesService provides access to the Conferences resource of the Twilio
+// API, which is used to manage conference calls. Conferences are created by
+// adding the first participant to them, or with the <Conference> TwiML noun.
+type ConferencesService struct {
+	client *Client
+}
+
+// ConferenceListParams are the filters used when listing conferences. All
+// fields are optional.
+type ConferenceListParams struct {
+	// Only show conferences in this status.
+	Status ConferenceStatus
+
+	// Only show conferences with this friendly name.
+	FriendlyName string
+
+	// Only show conferences created on this date.
+	DateCreated time.Time
+
+	// Only show conferences created on or after this date.
+	DateCreatedAfter time.Time
+
+	// Only show conferences created on or before this date.
+	DateCreatedBefore time.Time
+
+	// Only show conferences last updated on this date.
+	DateUpdated time.Time
+
+	// Only show conferences last updated on or after this date.
+	DateUpdatedAfter time.Time
+
+	// Only show conferences last updated on or before this date.
+	DateUpdatedBefore time.Time
+
+	ListOptions
+}
+
+func (p *ConferenceListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "Status", string(p.Status))
+	setString(v, "FriendlyName", p.FriendlyName)
+	setDate(v, "DateCreated", p.DateCreated)
+	setDate(v, "DateCreated>", p.DateCreatedAfter)
+	setDate(v, "DateCreated<", p.DateCreatedBefore)
+	setDate(v, "DateUpdated", p.DateUpdated)
+	setDate(v, "DateUpdated>", p.DateUpdatedAfter)
+	setDate(v, "DateUpdated<", p.DateUpdatedBefore)
+
+	return v
+}
+
+// ConferenceUpdateParams are the parameters used to update a conference.
+type ConferenceUpdateParams struct {
+	// The new status of the conference. Only completed is allowed, which
+	// ends the conference and disconnects all participants.
+	Status ConferenceStatus
+
+	// The URL of TwiML (<Say> or <Play>) to announce to all participants.
+	AnnounceURL string
+
+	// The HTTP method Twilio should use when requesting AnnounceURL.
+	AnnounceMethod string
+}
+
+func (p *ConferenceUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "Status", string(p.Status))
+	setString(v, "AnnounceUrl", p.AnnounceURL)
+	setString(v, "AnnounceMethod", p.AnnounceMethod)
+
+	return v
+}
+
+// List returns an iterator over the conferences matching the filters in
+// params. The params value may be nil to list all conferences.
+func (s *ConferencesService) List(params *ConferenceListParams) *Iterator[Conference] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Conference](s.client, "/Conferences", "conferences", params.values(), opts)
+}
+
+// Get fetches the conference with the given SID.
+func (s *ConferencesService) Get(ctx context.Context, sid string) (*Conference, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	conf := &Conference{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Conferences", sid), nil, conf); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// Update modifies the conference with the given SID.
+func (s *ConferencesService) Update(ctx context.Context, sid string, params *ConferenceUpdateParams) (*Conference, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*ConferenceUpdateParams cannot be nil")
+	}
+
+	conf := &Conference{}
+
+	if err := s.client.post(ctx, resourcePath("Conferences", sid), params.values(), conf); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// End ends the conference with the given SID, disconnecting all participants.
+func (s *ConferencesService) End(ctx context.Context, sid string) (*Conference, error) {
+	return s.Update(ctx, sid, &ConferenceUpdateParams{Status: ConferenceStatusCompleted})
+}
+
+// Participants returns a ParticipantsService for the participants of the
+// conference. When adding participants, the conference may be identified by
+// its friendly name instead of its SID, which creates the conference if it
+// does not exist yet.
+func (s *ConferencesService) Participants(conference string) *ParticipantsService {
+	return &ParticipantsService{client: s.client, conference: conference}
+}
+
+// ParticipantsService provides access to the Participants subresource of a
+// conference.
+type ParticipantsService struct {
+	client     *Client
+	conference string
+}
+
+// ParticipantParams are the parameters used to dial a new participant in to a
+// conference. From and To are required.
+type ParticipantParams struct {
+	// The phone number or client identifier to use as the caller ID.
+	From string
+
+	// The phone number, SIP address, or client identifier to dial.
+	To string
+
+	// A label for the participant, which can be used in place of its CallSID.
+	Label string
+
+	// Whether the participant joins muted.
+	Muted bool
+
+	// Whether to play a beep when the participant joins. Either true, false,
+	// onEnter, or onExit.
+	Beep string
+
+	// Whether the conference starts when the participant joins. Defaults to
+	// true.
+	StartConferenceOnEnter *bool
+
+	// Whether the conference ends when the participant leaves.
+	EndConferenceOnExit bool
+
+	// The number of seconds to let the call ring before giving up.
+	Timeout int
+
+	// The URL Twilio will send call progress events to.
+	StatusCallback string
+
+	// The call progress events that should be sent to StatusCallback.
+	StatusCallbackEvent []string
+
+	// The SID of a participant to coach. Only that participant will hear the
+	// new participant.
+	CallSIDToCoach string
+}
+
+func (p *ParticipantParams) validate() error {
+	if p == nil {
+		return errors.New("*ParticipantParams cannot be nil")
+	}
+
+	if len(p.From) == 0 {
+		return errors.New("From cannot be zero length")
+	}
+
+	if len(p.To) == 0 {
+		return errors.New("To cannot be zero length")
+	}
+
+	return nil
+}
+
+func (p *ParticipantParams) values() url.Values {
+	v := url.Values{}
+
+	v.Set("From", p.From)
+	v.Set("To", p.To)
+
+	setString(v, "Label", p.Label)
+
+	if p.Muted {
+		v.Set("Muted", "true")
+	}
+
+	setString(v, "Beep", p.Beep)
+	setBool(v, "StartConferenceOnEnter", p.StartConferenceOnEnter)
+
+	if p.EndConferenceOnExit {
+		v.Set("EndConferenceOnExit", "true")
+	}
+
+	setInt(v, "Timeout", p.Timeout)
+	setString(v, "StatusCallback", p.StatusCallback)
+
+	for _, e := range p.StatusCallbackEvent {
+		v.Add("StatusCallbackEvent", e)
+	}
+
+	if len(p.CallSIDToCoach) > 0 {
+		v.Set("Coaching", "true")
+		v.Set("CallSidToCoach", p.CallSIDToCoach)
+	}
+
+	return v
+}
+
+// ParticipantUpdateParams are the parameters used to update a participant.
+// Only the fields that are set are changed.
+type ParticipantUpdateParams struct {
+	// Whether the participant is muted.
+	Muted *bool
+
+	// Whether the participant is on hold.
+	Hold *bool
+
+	// The URL of the TwiML to play to the participant while on hold.
+	HoldURL string
+
+	// Whether the participant is coaching CallSIDToCoach.
+	Coaching *bool
+
+	// The SID of the participant to coach.
+	CallSIDToCoach string
+
+	// The URL of TwiML (<Say> or <Play>) to announce to the participant.
+	AnnounceURL string
+}
+
+func (p *ParticipantUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	setBool(v, "Muted", p.Muted)
+	setBool(v, "Hold", p.Hold)
+	setString(v, "HoldUrl", p.HoldURL)
+	setBool(v, "Coaching", p.Coaching)
+	setString(v, "CallSidToCoach", p.CallSIDToCoach)
+	setString(v, "AnnounceUrl", p.AnnounceURL)
+
+	return v
+}
+
+// ParticipantListParams are the filters used when listing participants. All
+// fields are optional.
+type ParticipantListParams struct {
+	// Only show participants that are, or are not, muted.
+	Muted *bool
+
+	// Only show participants that are, or are not, on hold.
+	Hold *bool
+
+	// Only show participants that are, or are not, coaching.
+	Coaching *bool
+
+	ListOptions
+}
+
+func (p *ParticipantListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setBool(v, "Muted", p.Muted)
+	setBool(v, "Hold", p.Hold)
+	setBool(v, "Coaching", p.Coaching)
+
+	return v
+}
+
+func (s *ParticipantsService) resource(callSID string) (string, error) {
+	if len(s.conference) == 0 {
+		return "", errors.New("conference cannot be zero length")
+	}
+
+	if len(callSID) == 0 {
+		return resourcePath("Conferences", s.conference, "Participants"), nil
+	}
+
+	return resourcePath("Conferences", s.conference, "Participants", callSID), nil
+}
+
+// Add dials a new participant in to the conference.
+func (s *ParticipantsService) Add(ctx context.Context, params *ParticipantParams) (*Participant, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	resource, err := s.resource("")
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Participant{}
+
+	if err = s.client.post(ctx, resource, params.values(), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// AddAll dials each of the numbers in to the conference, using params for
+// everything but the To of each participant. It attempts to add every number
+// even if some fail, returning the participants that were added along with an
+// error describing each failure.
+func (s *ParticipantsService) AddAll(ctx context.Context, params *ParticipantParams, to ...string) ([]*Participant, error) {
+	if params == nil {
+		return nil, errors.New("*ParticipantParams cannot be nil")
+	}
+
+	var participants []*Participant
+	var errs []error
+
+	for _, number := range to {
+		p := *params
+		p.To = number
+
+		participant, err := s.Add(ctx, &p)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to add %s: %w", number, err))
+			continue
+		}
+
+		participants = append(participants, participant)
+	}
+
+	return participants, errors.Join(errs...)
+}
+
+// Get fetches the participant with the given call SID.
+func (s *ParticipantsService) Get(ctx context.Context, callSID string) (*Participant, error) {
+	if len(callSID) == 0 {
+		return nil, errors.New("call sid cannot be zero length")
+	}
+
+	resource, err := s.resource(callSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Participant{}
+
+	if err = s.client.getJSON(ctx, resource, nil, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// List returns an iterator over the participants matching the filters in
+// params. The params value may be nil to list all participants.
+func (s *ParticipantsService) List(params *ParticipantListParams) *Iterator[Participant] {
+	resource, err := s.resource("")
+
+	if err != nil {
+		return errIterator[Participant](err)
+	}
+
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Participant](s.client, resource, "participants", params.values(), opts)
+}
+
+// Update modifies the participant with the given call SID.
+func (s *ParticipantsService) Update(ctx context.Context, callSID string, params *ParticipantUpdateParams) (*Participant, error) {
+	if len(callSID) == 0 {
+		return nil, errors.New("call sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*ParticipantUpdateParams cannot be nil")
+	}
+
+	resource, err := s.resource(callSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Participant{}
+
+	if err = s.client.post(ctx, resource, params.values(), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Mute mutes or unmutes the participant with the given call SID.
+func (s *ParticipantsService) Mute(ctx context.Context, callSID string, muted bool) (*Participant, error) {
+	return s.Update(ctx, callSID, &ParticipantUpdateParams{Muted: &muted})
+}
+
+// Hold puts the participant with the given call SID on hold, or takes them
+// off of it.
+func (s *ParticipantsService) Hold(ctx context.Context, callSID string, hold bool) (*Participant, error) {
+	return s.Update(ctx, callSID, &ParticipantUpdateParams{Hold: &hold})
+}
+
+// Coach makes the participant with the given call SID a coach of the
+// participant with the SID callSIDToCoach, so that only the coached
+// participant can hear them.
+func (s *ParticipantsService) Coach(ctx context.Context, callSID, callSIDToCoach string) (*Participant, error) {
+	if len(callSIDToCoach) == 0 {
+		return nil, errors.New("call sid to coach cannot be zero length")
+	}
+
+	coaching := true
+
+	return s.Update(ctx, callSID, &ParticipantUpdateParams{Coaching: &coaching, CallSIDToCoach: callSIDToCoach})
+}
+
+// Kick removes the participant with the given call SID from the conference,
+// hanging up their call.
+func (s *ParticipantsService) Kick(ctx context.Context, callSID string) error {
+	if len(callSID) == 0 {
+		return errors.New("call sid cannot be zero length")
+	}
+
+	resource, err := s.resource(callSID)
+
+	if err != nil {
+		return err
+	}
+
+	return s.client.delete(ctx, resource)
+}
@@ -0,0 +1,391 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestConferencesService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Conference]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Conference, error) {
+				return collect(ctx, c.Conferences.List(&ConferenceListParams{Status: ConferenceStatusInProgress, FriendlyName: "incident-42"}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Conferences.json", form: form("Status", "in-progress", "FriendlyName", "incident-42"),
+					body: `{"conferences": [{"sid": "CF1", "status": "in-progress"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Conference{{SID: "CF1", Status: ConferenceStatusInProgress}},
+			desc: `conferences should be filtered by status and friendly name`,
+		},
+	})
+}
+
+func TestConferencesService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Conference]{
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.Get(ctx, "CF1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Conferences/CF1.json", body: `{"sid": "CF1", "friendly_name": "incident-42", "status": "in-progress"}`},
+			},
+			out:  &Conference{SID: "CF1", FriendlyName: "incident-42", Status: ConferenceStatusInProgress},
+			desc: `the conference should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestConferencesService_Update(t *testing.T) {
+	testService(t, []serviceTest[*Conference]{
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.Update(ctx, "CF1", &ConferenceUpdateParams{AnnounceURL: "https://example.org/announce"})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Conferences/CF1.json", form: form("AnnounceUrl", "https://example.org/announce"),
+					body: `{"sid": "CF1", "status": "in-progress"}`,
+				},
+			},
+			out:  &Conference{SID: "CF1", Status: ConferenceStatusInProgress},
+			desc: `only the fields that are set should be sent`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.Update(ctx, "CF1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.Update(ctx, "", &ConferenceUpdateParams{Status: ConferenceStatusCompleted})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestConferencesService_End(t *testing.T) {
+	testService(t, []serviceTest[*Conference]{
+		{
+			call: func(ctx context.Context, c *Client) (*Conference, error) {
+				return c.Conferences.End(ctx, "CF1")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/CF1.json", form: form("Status", "completed"), body: `{"sid": "CF1", "status": "completed"}`},
+			},
+			out:  &Conference{SID: "CF1", Status: ConferenceStatusCompleted},
+			desc: `the conference should be completed`,
+		},
+	})
+}
+
+func TestParticipantsService_Add(t *testing.T) {
+	add := func(conference string) func(ctx context.Context, c *Client) (*Participant, error) {
+		return func(ctx context.Context, c *Client) (*Participant, error) {
+			return c.Conferences.Participants(conference).Add(ctx, &ParticipantParams{From: "+15005550006", To: "+15005550001"})
+		}
+	}
+
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Add(ctx, &ParticipantParams{
+					From:                "+15005550006",
+					To:                  "+15005550001",
+					Muted:               true,
+					EndConferenceOnExit: true,
+					CallSIDToCoach:      "CA2",
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Conferences/CF1/Participants.json",
+					form: form(
+						"From", "+15005550006", "To", "+15005550001", "Muted", "true",
+						"EndConferenceOnExit", "true", "Coaching", "true", "CallSidToCoach", "CA2",
+					),
+					body: `{"call_sid": "CA1", "conference_sid": "CF1", "status": "queued"}`,
+				},
+			},
+			out:  &Participant{CallSID: "CA1", ConferenceSID: "CF1", Status: ParticipantStatusQueued},
+			desc: `the participant should be dialed into the conference`,
+		},
+		{
+			call: add("incident-42"),
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550001"), body: `{"call_sid": "CA1"}`},
+			},
+			out:  &Participant{CallSID: "CA1"},
+			desc: `a friendly name should be used as the conference`,
+		},
+		{
+			call: add("Incident #42"),
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/Incident%20%2342/Participants.json", form: form("From", "+15005550006", "To", "+15005550001"), body: `{"call_sid": "CA1"}`},
+			},
+			out:  &Participant{CallSID: "CA1"},
+			desc: `a friendly name with a space and # should be escaped`,
+		},
+		{
+			call: add("sev1/db"),
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/sev1%2Fdb/Participants.json", form: form("From", "+15005550006", "To", "+15005550001"), body: `{"call_sid": "CA1"}`},
+			},
+			out:  &Participant{CallSID: "CA1"},
+			desc: `a friendly name with a / should be escaped`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Add(ctx, &ParticipantParams{From: "+15005550006"})
+			},
+			err:  true,
+			desc: `an empty To should be rejected`,
+		},
+		{
+			call: add(""),
+			err:  true,
+			desc: `an empty conference should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_AddAll(t *testing.T) {
+	testService(t, []serviceTest[[]*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) ([]*Participant, error) {
+				return c.Conferences.Participants("incident-42").AddAll(ctx, &ParticipantParams{From: "+15005550006"}, "+15005550002", "+15005550003")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550002"), body: `{"call_sid": "CA2"}`},
+				{method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550003"), body: `{"call_sid": "CA3"}`},
+			},
+			out:  []*Participant{{CallSID: "CA2"}, {CallSID: "CA3"}},
+			desc: `each number should be added`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]*Participant, error) {
+				participants, err := c.Conferences.Participants("incident-42").AddAll(ctx, &ParticipantParams{From: "+15005550006"}, "+15005550002", "+15005550001", "+15005550003")
+
+				if err == nil {
+					return participants, errors.New("AddAll() = _, <nil>; want error")
+				}
+
+				return participants, nil
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550002"), body: `{"call_sid": "CA2"}`},
+				{
+					method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550001"),
+					status: http.StatusBadRequest, body: `{"code": 21211, "message": "invalid number", "status": 400}`,
+				},
+				{method: "POST", path: "/x/Conferences/incident-42/Participants.json", form: form("From", "+15005550006", "To", "+15005550003"), body: `{"call_sid": "CA3"}`},
+			},
+			out:  []*Participant{{CallSID: "CA2"}, {CallSID: "CA3"}},
+			desc: `a failed number should not stop the others from being added`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]*Participant, error) {
+				return c.Conferences.Participants("incident-42").AddAll(ctx, nil, "+15005550002")
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Get(ctx, "CA1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Conferences/CF1/Participants/CA1.json", body: `{"call_sid": "CA1", "status": "connected"}`},
+			},
+			out:  &Participant{CallSID: "CA1", Status: ParticipantStatusConnected},
+			desc: `the participant should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("").Get(ctx, "CA1")
+			},
+			err:  true,
+			desc: `an empty conference should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_List(t *testing.T) {
+	muted := true
+
+	testService(t, []serviceTest[[]Participant]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Participant, error) {
+				return collect(ctx, c.Conferences.Participants("CF1").List(&ParticipantListParams{Muted: &muted}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Conferences/CF1/Participants.json", form: form("Muted", "true"),
+					body: `{"participants": [{"call_sid": "CA1", "muted": true}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Participant{{CallSID: "CA1", Muted: true}},
+			desc: `participants should be filtered by muted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Participant, error) {
+				return collect(ctx, c.Conferences.Participants("").List(nil))
+			},
+			err:  true,
+			desc: `an empty conference should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_Update(t *testing.T) {
+	muted, hold := true, false
+
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Update(ctx, "CA1", &ParticipantUpdateParams{Muted: &muted, Hold: &hold})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Conferences/CF1/Participants/CA1.json", form: form("Muted", "true", "Hold", "false"),
+					body: `{"call_sid": "CA1", "muted": true, "hold": false}`,
+				},
+			},
+			out:  &Participant{CallSID: "CA1", Muted: true},
+			desc: `only the fields that are set should be sent`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Update(ctx, "CA1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Update(ctx, "", &ParticipantUpdateParams{Muted: &muted})
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_Mute(t *testing.T) {
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Mute(ctx, "CA1", true)
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/CF1/Participants/CA1.json", form: form("Muted", "true"), body: `{"call_sid": "CA1", "muted": true}`},
+			},
+			out:  &Participant{CallSID: "CA1", Muted: true},
+			desc: `the participant should be muted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Mute(ctx, "CA1", false)
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/CF1/Participants/CA1.json", form: form("Muted", "false"), body: `{"call_sid": "CA1", "muted": false}`},
+			},
+			out:  &Participant{CallSID: "CA1"},
+			desc: `the participant should be unmuted`,
+		},
+	})
+}
+
+func TestParticipantsService_Hold(t *testing.T) {
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Hold(ctx, "CA1", true)
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Conferences/CF1/Participants/CA1.json", form: form("Hold", "true"), body: `{"call_sid": "CA1", "hold": true}`},
+			},
+			out:  &Participant{CallSID: "CA1", Hold: true},
+			desc: `the participant should be put on hold`,
+		},
+	})
+}
+
+func TestParticipantsService_Coach(t *testing.T) {
+	testService(t, []serviceTest[*Participant]{
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Coach(ctx, "CA1", "CA2")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Conferences/CF1/Participants/CA1.json", form: form("Coaching", "true", "CallSidToCoach", "CA2"),
+					body: `{"call_sid": "CA1", "coaching": true, "call_sid_to_coach": "CA2"}`,
+				},
+			},
+			out:  &Participant{CallSID: "CA1", Coaching: true, CallSIDToCoach: "CA2"},
+			desc: `the participant should coach the other call`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Participant, error) {
+				return c.Conferences.Participants("CF1").Coach(ctx, "CA1", "")
+			},
+			err:  true,
+			desc: `an empty call to coach should be rejected`,
+		},
+	})
+}
+
+func TestParticipantsService_Kick(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Conferences.Participants("CF1").Kick(ctx, "CA1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Conferences/CF1/Participants/CA1.json", status: http.StatusNoContent},
+			},
+			desc: `the participant should be removed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Conferences.Participants("CF1").Kick(ctx, "")
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
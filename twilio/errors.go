@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Twilio error codes that are used to classify an *Exception. The full list
+// can be found at https://www.twilio.com/docs/api/errors.
+const (
+	codeAuthenticate    = 20003
+	codeNotFound        = 20404
+	codeTooManyRequests = 20429
+)
+
+// Error implements the error interface.
+func (e *Exception) Error() string {
+	if e.Code == 0 {
+		return fmt.Sprintf("twilio: %s (status %d)", e.Message, e.Status)
+	}
+
+	return fmt.Sprintf("twilio: %s (status %d, code %d)", e.Message, e.Status, e.Code)
+}
+
+// checkResponse returns nil if the response has a 2xx status code. Otherwise
+// it reads the body of the response and returns it as an *Exception. If the
+// body is not a Twilio error resource, the Exception is built from the HTTP
+// status line and whatever the body contained.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return nil
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	e := &Exception{}
+
+	if err := json.Unmarshal(body, e); err != nil || e.Message == "" {
+		e = &Exception{Message: strings.TrimSpace(string(body))}
+	}
+
+	if e.Status == 0 {
+		e.Status = resp.StatusCode
+	}
+
+	if e.Message == "" {
+		e.Message = http.StatusText(resp.StatusCode)
+	}
+
+	return e
+}
+
+// IsNotFound returns true if err is an *Exception indicating that the
+// requested resource does not exist.
+func IsNotFound(err error) bool {
+	var e *Exception
+
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return e.Status == http.StatusNotFound || e.Code == codeNotFound
+}
+
+// IsRateLimited returns true if err is an *Exception indicating that the
+// request was rejected because it exceeded the Twilio API's rate limits.
+func IsRateLimited(err error) bool {
+	var e *Exception
+
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return e.Status == http.StatusTooManyRequests || e.Code == codeTooManyRequests
+}
+
+// IsAuthError returns true if err is an *Exception indicating that the
+// credentials used for the request were invalid or lacked permission.
+func IsAuthError(err error) bool {
+	var e *Exception
+
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return e.Status == http.StatusUnauthorized ||
+		e.Status == http.StatusForbidden ||
+		e.Code == codeAuthenticate
+}
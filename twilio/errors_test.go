@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_checkResponse(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		out    *Exception
+		desc   string
+	}{
+		{status: 200, body: `{}`, desc: `2xx responses are not errors`},
+		{status: 204, desc: `2xx responses are not errors`},
+		{
+			status: 404,
+			body:   `{"code": 20404, "message": "The requested resource was not found", "more_info": "https://www.twilio.com/docs/errors/20404", "status": 404}`,
+			out:    &Exception{Status: 404, Code: 20404, Message: "The requested resource was not found", MoreInfo: "https://www.twilio.com/docs/errors/20404"},
+			desc:   `Twilio error resources should be decoded`,
+		},
+		{
+			status: 502,
+			body:   "bad gateway\n",
+			out:    &Exception{Status: 502, Message: "bad gateway"},
+			desc:   `non-JSON bodies should be used as the message`,
+		},
+		{
+			status: 503,
+			out:    &Exception{Status: 503, Message: "Service Unavailable"},
+			desc:   `empty bodies should use the status text`,
+		},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{
+			StatusCode: tt.status,
+			Body:       ioutil.NopCloser(strings.NewReader(tt.body)),
+		}
+
+		err := checkResponse(resp)
+
+		if tt.out == nil {
+			if err != nil {
+				t.Errorf("\nDescription: %s\ncheckResponse() = %s; want <nil>", tt.desc, err)
+			}
+			continue
+		}
+
+		e, ok := err.(*Exception)
+
+		if !ok {
+			t.Errorf("\nDescription: %s\ncheckResponse() = %#v; want *Exception", tt.desc, err)
+			continue
+		}
+
+		if *e != *tt.out {
+			t.Errorf("\nDescription: %s\ncheckResponse() = %#v; want %#v", tt.desc, e, tt.out)
+		}
+	}
+}
+
+func TestException_helpers(t *testing.T) {
+	tests := []struct {
+		err                         error
+		notFound, rateLimited, auth bool
+	}{
+		{err: errors.New("nope")},
+		{err: &Exception{Status: 400, Code: 21211}},
+		{err: &Exception{Status: 404, Code: 20404}, notFound: true},
+		{err: fmt.Errorf("wrapped: %w", &Exception{Status: 404}), notFound: true},
+		{err: &Exception{Status: 429, Code: 20429}, rateLimited: true},
+		{err: &Exception{Status: 401, Code: 20003}, auth: true},
+		{err: &Exception{Status: 403}, auth: true},
+	}
+
+	for _, tt := range tests {
+		if got := IsNotFound(tt.err); got != tt.notFound {
+			t.Errorf("IsNotFound(%v) = %t; want %t", tt.err, got, tt.notFound)
+		}
+
+		if got := IsRateLimited(tt.err); got != tt.rateLimited {
+			t.Errorf("IsRateLimited(%v) = %t; want %t", tt.err, got, tt.rateLimited)
+		}
+
+		if got := IsAuthError(tt.err); got != tt.auth {
+			t.Errorf("IsAuthError(%v) = %t; want %t", tt.err, got, tt.auth)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// IncomingPhoneNumbersService provides access to the IncomingPhoneNumbers
+// resource of the Twilio API, which is used to buy, configure, and release
+// the phone numbers owned by an account.
+type IncomingPhoneNumbersService struct {
+	client *Client
+}
+
+// IncomingPhoneNumberConfig is the configuration of a phone number, which is
+// set when buying a number and may be changed by updating it. Only the fields
+// that are set are sent to Twilio.
+type IncomingPhoneNumberConfig struct {
+	// A human readable description of the phone number, up to 64 characters.
+	FriendlyName string
+
+	// The URL Twilio requests when the number receives a call.
+	VoiceURL string
+
+	// The HTTP method Twilio should use when requesting VoiceURL.
+	VoiceMethod string
+
+	// The URL Twilio requests if an error occurs requesting VoiceURL.
+	VoiceFallbackURL string
+
+	// The HTTP method Twilio should use when requesting VoiceFallbackURL.
+	VoiceFallbackMethod string
+
+	// The SID of an application to handle calls to the number. If set, the
+	// voice URLs are ignored.
+	VoiceApplicationSID string
+
+	// The URL Twilio requests when the number receives a message.
+	SmsURL string
+
+	// The HTTP method Twilio should use when requesting SmsURL.
+	SmsMethod string
+
+	// The URL Twilio requests if an error occurs requesting SmsURL.
+	SmsFallbackURL string
+
+	// The HTTP method Twilio should use when requesting SmsFallbackURL.
+	SmsFallbackMethod string
+
+	// The SID of an application to handle messages to the number. If set,
+	// the SMS URLs are ignored.
+	SmsApplicationSID string
+
+	// The URL Twilio will send call status events for the number to.
+	StatusCallback string
+
+	// The HTTP method Twilio should use when requesting StatusCallback.
+	StatusCallbackMethod string
+
+	// The SID of the address registered for the number, where required by
+	// local regulations.
+	AddressSID string
+}
+
+func (p *IncomingPhoneNumberConfig) setValues(v url.Values) {
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "VoiceUrl", p.VoiceURL)
+	setString(v, "VoiceMethod", p.VoiceMethod)
+	setString(v, "VoiceFallbackUrl", p.VoiceFallbackURL)
+	setString(v, "VoiceFallbackMethod", p.VoiceFallbackMethod)
+	setString(v, "VoiceApplicationSid", p.VoiceApplicationSID)
+	setString(v, "SmsUrl", p.SmsURL)
+	setString(v, "SmsMethod", p.SmsMethod)
+	setString(v, "SmsFallbackUrl", p.SmsFallbackURL)
+	setString(v, "SmsFallbackMethod", p.SmsFallbackMethod)
+	setString(v, "SmsApplicationSid", p.SmsApplicationSID)
+	setString(v, "StatusCallback", p.StatusCallback)
+	setString(v, "StatusCallbackMethod", p.StatusCallbackMethod)
+	setString(v, "AddressSid", p.AddressSID)
+}
+
+// IncomingPhoneNumberParams are the parameters used to buy a phone number.
+// One of PhoneNumber or AreaCode is required.
+type IncomingPhoneNumberParams struct {
+	// The phone number to buy, in E.164 format.
+	PhoneNumber string
+
+	// The area code to buy any available number in. Only available for US
+	// and Canadian numbers.
+	AreaCode string
+
+	IncomingPhoneNumberConfig
+}
+
+func (p *IncomingPhoneNumberParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "PhoneNumber", p.PhoneNumber)
+	setString(v, "AreaCode", p.AreaCode)
+	p.IncomingPhoneNumberConfig.setValues(v)
+
+	return v
+}
+
+// IncomingPhoneNumberUpdateParams are the parameters used to update a phone
+// number. Only the fields that are set are changed.
+type IncomingPhoneNumberUpdateParams struct {
+	IncomingPhoneNumberConfig
+
+	// The SID of the account to move the phone number to. The number must be
+	// moved between a parent account and one of its subaccounts, using a
+	// client for the account that currently owns the number.
+	AccountSID string
+}
+
+func (p *IncomingPhoneNumberUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	p.IncomingPhoneNumberConfig.setValues(v)
+	setString(v, "AccountSid", p.AccountSID)
+
+	return v
+}
+
+// IncomingPhoneNumberListParams are the filters used when listing phone
+// numbers. All fields are optional.
+type IncomingPhoneNumberListParams struct {
+	// Only show phone numbers that match this pattern. Matches on the phone
+	// number in E.164 format, and may contain * as a wildcard.
+	PhoneNumber string
+
+	// Only show phone numbers with this friendly name.
+	FriendlyName string
+
+	ListOptions
+}
+
+func (p *IncomingPhoneNumberListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "PhoneNumber", p.PhoneNumber)
+	setString(v, "FriendlyName", p.FriendlyName)
+
+	return v
+}
+
+// Create buys a new phone number for the account.
+func (s *IncomingPhoneNumbersService) Create(ctx context.Context, params *IncomingPhoneNumberParams) (*IncomingPhoneNumber, error) {
+	if params == nil {
+		return nil, errors.New("*IncomingPhoneNumberParams cannot be nil")
+	}
+
+	if len(params.PhoneNumber) == 0 && len(params.AreaCode) == 0 {
+		return nil, errors.New("one of PhoneNumber or AreaCode must be set")
+	}
+
+	pn := &IncomingPhoneNumber{}
+
+	if err := s.client.post(ctx, "/IncomingPhoneNumbers", params.values(), pn); err != nil {
+		return nil, err
+	}
+
+	return pn, nil
+}
+
+// Get fetches the phone number with the given SID.
+func (s *IncomingPhoneNumbersService) Get(ctx context.Context, sid string) (*IncomingPhoneNumber, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	pn := &IncomingPhoneNumber{}
+
+	if err := s.client.getJSON(ctx, resourcePath("IncomingPhoneNumbers", sid), nil, pn); err != nil {
+		return nil, err
+	}
+
+	return pn, nil
+}
+
+// Update modifies the phone number with the given SID. This is used to point
+// the number at new webhook URLs, or to move it to another account.
+func (s *IncomingPhoneNumbersService) Update(ctx context.Context, sid string, params *IncomingPhoneNumberUpdateParams) (*IncomingPhoneNumber, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*IncomingPhoneNumberUpdateParams cannot be nil")
+	}
+
+	pn := &IncomingPhoneNumber{}
+
+	if err := s.client.post(ctx, resourcePath("IncomingPhoneNumbers", sid), params.values(), pn); err != nil {
+		return nil, err
+	}
+
+	return pn, nil
+}
+
+// Delete releases the phone number with the given SID. Once released, the
+// number may not be recoverable.
+func (s *IncomingPhoneNumbersService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("IncomingPhoneNumbers", sid))
+}
+
+// List returns an iterator over the phone numbers matching the filters in
+// params. The params value may be nil to list all phone numbers.
+func (s *IncomingPhoneNumbersService) List(params *IncomingPhoneNumberListParams) *Iterator[IncomingPhoneNumber] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[IncomingPhoneNumber](
+		s.client, "/IncomingPhoneNumbers", "incoming_phone_numbers",
+		params.values(), opts,
+	)
+}
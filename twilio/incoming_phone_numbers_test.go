@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestIncomingPhoneNumbersService_Create(t *testing.T) {
+	testService(t, []serviceTest[*IncomingPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Create(ctx, &IncomingPhoneNumberParams{PhoneNumber: "+15005550006"})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/IncomingPhoneNumbers.json", form: form("PhoneNumber", "+15005550006"),
+					status: http.StatusCreated,
+					body:   `{"sid": "PN1", "phone_number": "+15005550006", "capabilities": {"voice": true, "sms": true, "mms": false, "fax": false}}`,
+				},
+			},
+			out:  &IncomingPhoneNumber{SID: "PN1", PhoneNumber: "+15005550006", Capabilities: PhoneNumberCapabilities{Voice: true, SMS: true}},
+			desc: `the phone number should be bought`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Create(ctx, &IncomingPhoneNumberParams{
+					AreaCode:                  "415",
+					IncomingPhoneNumberConfig: IncomingPhoneNumberConfig{FriendlyName: "pager", SmsURL: "https://example.org/sms"},
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/IncomingPhoneNumbers.json",
+					form:   form("AreaCode", "415", "FriendlyName", "pager", "SmsUrl", "https://example.org/sms"),
+					status: http.StatusCreated, body: `{"sid": "PN1", "friendly_name": "pager"}`,
+				},
+			},
+			out:  &IncomingPhoneNumber{SID: "PN1", FriendlyName: "pager"},
+			desc: `a number in the area code should be bought with the config`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Create(ctx, &IncomingPhoneNumberParams{})
+			},
+			err:  true,
+			desc: `setting neither PhoneNumber nor AreaCode should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestIncomingPhoneNumbersService_Get(t *testing.T) {
+	testService(t, []serviceTest[*IncomingPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Get(ctx, "PN1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/IncomingPhoneNumbers/PN1.json", body: `{"sid": "PN1", "account_sid": "x"}`},
+			},
+			out:  &IncomingPhoneNumber{SID: "PN1", AccountSID: "x"},
+			desc: `the phone number should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestIncomingPhoneNumbersService_Update(t *testing.T) {
+	testService(t, []serviceTest[*IncomingPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Update(ctx, "PN1", &IncomingPhoneNumberUpdateParams{
+					IncomingPhoneNumberConfig: IncomingPhoneNumberConfig{
+						VoiceURL: "https://us-west.example.org/voice",
+						SmsURL:   "https://us-west.example.org/sms",
+					},
+					AccountSID: "AC2",
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/IncomingPhoneNumbers/PN1.json",
+					form: form("VoiceUrl", "https://us-west.example.org/voice", "SmsUrl", "https://us-west.example.org/sms", "AccountSid", "AC2"),
+					body: `{"sid": "PN1", "account_sid": "AC2", "voice_url": "https://us-west.example.org/voice", "sms_url": "https://us-west.example.org/sms"}`,
+				},
+			},
+			out: &IncomingPhoneNumber{
+				SID: "PN1", AccountSID: "AC2",
+				VoiceURL: "https://us-west.example.org/voice", SmsURL: "https://us-west.example.org/sms",
+			},
+			desc: `the number should be moved to AC2 with new URLs`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Update(ctx, "PN1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*IncomingPhoneNumber, error) {
+				return c.IncomingPhoneNumbers.Update(ctx, "", &IncomingPhoneNumberUpdateParams{AccountSID: "AC2"})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestIncomingPhoneNumbersService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.IncomingPhoneNumbers.Delete(ctx, "PN1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/IncomingPhoneNumbers/PN1.json", status: http.StatusNoContent},
+			},
+			desc: `the phone number should be released`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.IncomingPhoneNumbers.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestIncomingPhoneNumbersService_List(t *testing.T) {
+	testService(t, []serviceTest[[]IncomingPhoneNumber]{
+		{
+			call: func(ctx context.Context, c *Client) ([]IncomingPhoneNumber, error) {
+				return collect(ctx, c.IncomingPhoneNumbers.List(&IncomingPhoneNumberListParams{FriendlyName: "pager"}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/IncomingPhoneNumbers.json", form: form("FriendlyName", "pager"),
+					body: `{"incoming_phone_numbers": [{"sid": "PN1"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []IncomingPhoneNumber{{SID: "PN1"}},
+			desc: `phone numbers should be filtered by friendly name`,
+		},
+	})
+}
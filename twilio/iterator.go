@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ListOptions control the paging of list resources. The zero value fetches
+// every page using the Twilio default page size.
+type ListOptions struct {
+	// The number of items to request per page. Twilio allows up to 1000.
+	PageSize int
+
+	// The maximum number of pages to fetch. Zero means no limit.
+	MaxPages int
+
+	// The maximum number of items to return. Zero means no limit.
+	MaxItems int
+}
+
+// Iterator walks the items of a Twilio list resource, transparently fetching
+// additional pages by following the next_page_uri of each page. It is used
+// like so:
+//
+//	it := client.Calls.List(nil)
+//
+//	for it.Next(ctx) {
+//		call := it.Value()
+//		// ...
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	client *Client
+	key    string
+	opts   ListOptions
+
+	// next is the URL of the next page, and is empty once the last page has
+	// been fetched.
+	next string
+
+	// more, if non-nil, returns the URL of the next list resource to continue
+	// with once the current one has been exhausted. It returns an empty string
+	// when there are no more.
+	more func(ctx context.Context) (string, error)
+
+	items []T
+	value T
+	pages int
+	count int
+	err   error
+}
+
+// newIterator returns an Iterator for the list resource within the client's
+// account. The key is the name of the JSON array within each page holding the
+// items (e.g., "calls").
+func newIterator[T any](c *Client, resource, key string, values url.Values, opts ListOptions) *Iterator[T] {
+	return newURLIterator[T](c, c.resourceURL(resource), key, values, opts)
+}
+
+// newURLIterator is like newIterator, but for a list resource at an absolute
+// URL.
+func newURLIterator[T any](c *Client, urlStr, key string, values url.Values, opts ListOptions) *Iterator[T] {
+	if values == nil {
+		values = url.Values{}
+	}
+
+	setInt(values, "PageSize", opts.PageSize)
+
+	return &Iterator[T]{
+		client: c,
+		key:    key,
+		opts:   opts,
+		next:   urlStr + formatValues(values),
+	}
+}
+
+// newChainIterator returns an Iterator over each of the list resources within
+// the client's account returned by resources, in turn, as if they were a single
+// list. The limits in opts apply across all of them. The resources function
+// returns an empty string when there are no more list resources.
+func newChainIterator[T any](c *Client, key string, opts ListOptions, resources func(ctx context.Context) (string, error)) *Iterator[T] {
+	values := url.Values{}
+	setInt(values, "PageSize", opts.PageSize)
+
+	it := &Iterator[T]{client: c, key: key, opts: opts}
+
+	it.more = func(ctx context.Context) (string, error) {
+		resource, err := resources(ctx)
+
+		if err != nil || len(resource) == 0 {
+			return "", err
+		}
+
+		return c.resourceURL(resource) + formatValues(values), nil
+	}
+
+	return it
+}
+
+// errIterator returns an Iterator that yields no items, and whose Err method
+// returns err.
+func errIterator[T any](err error) *Iterator[T] {
+	return &Iterator[T]{err: err}
+}
+
+// Next advances the iterator to the next item, fetching the next page using
+// ctx if needed. It returns false when there are no more items, a limit from the
+// ListOptions has been reached, or an error occurred. Err should be checked
+// after Next returns false.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.opts.MaxItems > 0 && it.count >= it.opts.MaxItems {
+		return false
+	}
+
+	for len(it.items) == 0 {
+		if len(it.next) == 0 {
+			if it.more == nil {
+				return false
+			}
+
+			if it.next, it.err = it.more(ctx); it.err != nil {
+				return false
+			}
+
+			if len(it.next) == 0 {
+				it.more = nil
+				return false
+			}
+		}
+
+		if it.opts.MaxPages > 0 && it.pages >= it.opts.MaxPages {
+			return false
+		}
+
+		if it.err = it.fetch(ctx); it.err != nil {
+			return false
+		}
+	}
+
+	it.value, it.items = it.items[0], it.items[1:]
+	it.count++
+
+	return true
+}
+
+// Value returns the current item. It is only valid after a call to Next that
+// returned true.
+func (it *Iterator[T]) Value() T { return it.value }
+
+// Err returns the first error encountered while iterating, if any.
+func (it *Iterator[T]) Err() error { return it.err }
+
+// fetch requests the next page, and records the URL of the page after it, if
+// there is one.
+func (it *Iterator[T]) fetch(ctx context.Context) error {
+	req, err := newURLRequest(ctx, it.client, "GET", it.next, nil)
+
+	if err != nil {
+		return err
+	}
+
+	var page map[string]json.RawMessage
+
+	if err = it.client.do(req, &page); err != nil {
+		return err
+	}
+
+	it.pages++
+	it.next = ""
+
+	if raw, ok := page[it.key]; ok {
+		if err := json.Unmarshal(raw, &it.items); err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("list response has no %q field", it.key)
+	}
+
+	var next string
+
+	if raw, ok := page["next_page_uri"]; ok {
+		if err := json.Unmarshal(raw, &next); err != nil {
+			return err
+		}
+	}
+
+	if len(next) == 0 {
+		return nil
+	}
+
+	it.next, err = resolveURI(it.client.BaseURL, next)
+
+	return err
+}
+
+// resolveURI resolves a URI returned by Twilio, which is relative to the API
+// host (e.g., "/2010-04-01/Accounts/AC.../Calls.json?Page=1"), against the
+// base URL of the client.
+func resolveURI(base, uri string) (string, error) {
+	b, err := url.Parse(base)
+
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	return b.ResolveReference(u).String(), nil
+}
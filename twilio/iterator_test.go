@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pagingHandler serves three pages of two items each under the "items" key,
+// linking each page to the next with a next_page_uri.
+func pagingHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/x/Items.json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("Page"))
+
+	if page > 2 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	next := "null"
+
+	if page < 2 {
+		next = fmt.Sprintf(`"/x/Items.json?Page=%d&PageSize=2"`, page+1)
+	}
+
+	fmt.Fprintf(
+		w, `{"items": [{"sid": "IT%d"}, {"sid": "IT%d"}], "page": %d, "page_size": 2, "next_page_uri": %s}`,
+		page*2, page*2+1, page, next,
+	)
+}
+
+type testItem struct {
+	SID string `json:"sid"`
+}
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+
+	client, done := setUpTestClient(t, pagingHandler)
+
+	defer done()
+
+	tests := []struct {
+		opts ListOptions
+		n    int
+		desc string
+	}{
+		{opts: ListOptions{}, n: 6, desc: `all pages should be fetched`},
+		{opts: ListOptions{MaxPages: 2}, n: 4, desc: `only two pages should be fetched`},
+		{opts: ListOptions{MaxItems: 3}, n: 3, desc: `only three items should be returned`},
+		{opts: ListOptions{MaxPages: 1, MaxItems: 3}, n: 2, desc: `the page limit should apply first`},
+	}
+
+	for _, tt := range tests {
+		it := newIterator[testItem](client, "/Items", "items", nil, tt.opts)
+
+		var sids []string
+
+		for it.Next(ctx) {
+			sids = append(sids, it.Value().SID)
+		}
+
+		if err := it.Err(); err != nil {
+			t.Errorf("\nDescription: %s\nit.Err() = %s; want <nil>", tt.desc, err)
+			continue
+		}
+
+		if len(sids) != tt.n {
+			t.Errorf("\nDescription: %s\nlen(sids) = %d; want %d", tt.desc, len(sids), tt.n)
+			continue
+		}
+
+		for i, sid := range sids {
+			if want := fmt.Sprintf("IT%d", i); sid != want {
+				t.Errorf("\nDescription: %s\nsids[%d] = %q; want %q", tt.desc, i, sid, want)
+			}
+		}
+	}
+
+	it := newIterator[testItem](client, "/Nope", "items", nil, ListOptions{})
+
+	if it.Next(ctx) {
+		t.Error("it.Next(ctx) = true; want false")
+	}
+
+	if !IsNotFound(it.Err()) {
+		t.Errorf("it.Err() = %v; want not found *Exception", it.Err())
+	}
+}
+
+func Test_resolveURI(t *testing.T) {
+	tests := []struct {
+		base, uri, out string
+	}{
+		{TwilioAPIBase, "/2010-04-01/Accounts/AC1/Calls.json?Page=1", "https://api.twilio.com/2010-04-01/Accounts/AC1/Calls.json?Page=1"},
+		{"http://127.0.0.1:8080", "/x/Calls.json?Page=1", "http://127.0.0.1:8080/x/Calls.json?Page=1"},
+	}
+
+	for _, tt := range tests {
+		out, err := resolveURI(tt.base, tt.uri)
+
+		if err != nil {
+			t.Errorf("resolveURI(%q, %q) = _, %s; want <nil>", tt.base, tt.uri, err)
+			continue
+		}
+
+		if out != tt.out {
+			t.Errorf("resolveURI(%q, %q) = %q; want %q", tt.base, tt.uri, out, tt.out)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// KeysService provides access to the Keys and SigningKeys resources of the
+// Twilio API, which are used to manage API keys.
+type KeysService struct {
+	client   *Client
+	resource string
+	key      string
+}
+
+// Create creates a new key with the given friendly name, which may be empty.
+// The Secret of the returned key can not be retrieved again later.
+func (s *KeysService) Create(ctx context.Context, friendlyName string) (*Key, error) {
+	v := url.Values{}
+	setString(v, "FriendlyName", friendlyName)
+
+	key := &Key{}
+
+	if err := s.client.post(ctx, s.resource, v, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// Get fetches the key with the given SID.
+func (s *KeysService) Get(ctx context.Context, sid string) (*Key, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	key := &Key{}
+
+	if err := s.client.getJSON(ctx, s.resource+resourcePath(sid), nil, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// List returns an iterator over the account's keys.
+func (s *KeysService) List(opts ListOptions) *Iterator[Key] {
+	return newIterator[Key](s.client, s.resource, s.key, nil, opts)
+}
+
+// Update changes the friendly name of the key with the given SID.
+func (s *KeysService) Update(ctx context.Context, sid, friendlyName string) (*Key, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	v := url.Values{}
+	v.Set("FriendlyName", friendlyName)
+
+	key := &Key{}
+
+	if err := s.client.post(ctx, s.resource+resourcePath(sid), v, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// Delete removes the key with the given SID. Requests authenticated with the
+// key fail once it is deleted.
+func (s *KeysService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, s.resource+resourcePath(sid))
+}
+
+// RotateKey replaces the API key the client authenticates with. The client
+// must currently be authenticating with old, and must have its AccountSID set
+// (e.g., by NewWithAPIKey). It can not be used on a client created by
+// Subaccount, as the key belongs to the parent account; rotate the key using
+// the parent client instead.
+//
+// RotateKey creates a new key with the same friendly name, verifies the new
+// key by listing a single message with it, swaps the credentials of the client
+// to it, and then deletes old. The new key, including its Secret, is returned
+// so that it can be persisted.
+//
+// Keys are created and deleted using old, which must be a Main key, as
+// Standard keys can not manage keys. Keys created through the API are Standard
+// keys, so the rotated client can not itself rotate keys again.
+//
+// If the new key fails verification it is deleted, and the client keeps using
+// old. If deleting old fails, the client keeps using the new key, and both the
+// new key and the error are returned.
+func (c *Client) RotateKey(ctx context.Context, old Key) (*Key, error) {
+	if len(old.SID) == 0 {
+		return nil, errors.New("old key sid cannot be zero length")
+	}
+
+	if c.parent != nil {
+		return nil, errors.New("keys must be rotated using the parent client of a subaccount")
+	}
+
+	if len(c.AccountSID) == 0 {
+		return nil, errors.New("AccountSID must be set to rotate keys")
+	}
+
+	sid, secret := c.Credentials()
+
+	if sid != old.SID {
+		return nil, fmt.Errorf("client is not authenticating with key %s", old.SID)
+	}
+
+	key, err := c.Keys.Create(ctx, old.FriendlyName)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new key: %w", err)
+	}
+
+	// Standard keys can not manage keys, so verify the new key with a
+	// request that any key may make
+	v := url.Values{}
+	v.Set("PageSize", "1")
+
+	if err = c.doAs(ctx, key.SID, key.Secret, "GET", "/Messages", v, nil); err != nil {
+		_ = c.doAs(ctx, sid, secret, "DELETE", c.Keys.resource+resourcePath(key.SID), nil, nil)
+		return nil, fmt.Errorf("failed to verify new key: %w", err)
+	}
+
+	if !c.swapCredentials(old.SID, key.SID, key.Secret) {
+		_ = c.doAs(ctx, sid, secret, "DELETE", c.Keys.resource+resourcePath(key.SID), nil, nil)
+		return nil, errors.New("credentials were changed during key rotation")
+	}
+
+	if err = c.doAs(ctx, sid, secret, "DELETE", c.Keys.resource+resourcePath(old.SID), nil, nil); err != nil {
+		return key, fmt.Errorf("failed to delete old key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,241 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestKeysService_Create(t *testing.T) {
+	testService(t, []serviceTest[*Key]{
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.Keys.Create(ctx, "houston")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Keys.json", form: form("FriendlyName", "houston"),
+					status: http.StatusCreated, body: `{"sid": "SK1", "friendly_name": "houston", "secret": "s3cr3t"}`,
+				},
+			},
+			out:  &Key{SID: "SK1", FriendlyName: "houston", Secret: "s3cr3t"},
+			desc: `the key should be created with its secret`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.SigningKeys.Create(ctx, "")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/SigningKeys.json", status: http.StatusCreated, body: `{"sid": "SK2", "secret": "s3cr3t"}`},
+			},
+			out:  &Key{SID: "SK2", Secret: "s3cr3t"},
+			desc: `signing keys should use the SigningKeys resource`,
+		},
+	})
+}
+
+func TestKeysService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Key]{
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.Keys.Get(ctx, "SK1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Keys/SK1.json", body: `{"sid": "SK1", "friendly_name": "houston"}`},
+			},
+			out:  &Key{SID: "SK1", FriendlyName: "houston"},
+			desc: `the key should be fetched without its secret`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.Keys.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestKeysService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Key]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Key, error) {
+				return collect(ctx, c.SigningKeys.List(ListOptions{}))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/SigningKeys.json", body: `{"signing_keys": [{"sid": "SK1"}, {"sid": "SK2"}], "next_page_uri": null}`},
+			},
+			out:  []Key{{SID: "SK1"}, {SID: "SK2"}},
+			desc: `signing keys should be listed from the signing_keys field`,
+		},
+	})
+}
+
+func TestKeysService_Update(t *testing.T) {
+	testService(t, []serviceTest[*Key]{
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.Keys.Update(ctx, "SK1", "houston-prod")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Keys/SK1.json", form: form("FriendlyName", "houston-prod"), body: `{"sid": "SK1", "friendly_name": "houston-prod"}`},
+			},
+			out:  &Key{SID: "SK1", FriendlyName: "houston-prod"},
+			desc: `the key should be renamed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Key, error) {
+				return c.Keys.Update(ctx, "", "houston-prod")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestKeysService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Keys.Delete(ctx, "SK1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Keys/SK1.json", status: http.StatusNoContent},
+			},
+			desc: `the key should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Keys.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+// rotation is the outcome of a call to RotateKey: the key it returned,
+// whether it failed, and the credentials the client was left with.
+type rotation struct {
+	key         *Key
+	failed      bool
+	sid, secret string
+}
+
+func TestClient_RotateKey(t *testing.T) {
+	// rotate calls RotateKey on a client authenticating with the Main key
+	// SKold, after applying setup to it
+	rotate := func(old Key, setup func(c *Client) *Client) func(ctx context.Context, c *Client) (rotation, error) {
+		return func(ctx context.Context, c *Client) (rotation, error) {
+			if err := c.SetCredentials("SKold", "old-secret"); err != nil {
+				return rotation{}, err
+			}
+
+			c.AccountSID = "x"
+
+			if setup != nil {
+				c = setup(c)
+			}
+
+			key, err := c.RotateKey(ctx, old)
+			sid, secret := c.Credentials()
+
+			return rotation{key: key, failed: err != nil, sid: sid, secret: secret}, nil
+		}
+	}
+
+	old := Key{SID: "SKold", FriendlyName: "houston"}
+
+	// keys created through the API are Standard keys, so creating and
+	// deleting keys must be done as SKold, and SKnew may only be used for the
+	// verification request
+	create := exchange{
+		method: "POST", path: "/x/Keys.json", form: form("FriendlyName", "houston"), user: "SKold",
+		status: http.StatusCreated, body: `{"sid": "SKnew", "friendly_name": "houston", "secret": "new-secret"}`,
+	}
+	verify := exchange{
+		method: "GET", path: "/x/Messages.json", form: form("PageSize", "1"), user: "SKnew",
+		body: `{"messages": [], "next_page_uri": null}`,
+	}
+	forbidden := `{"code": 20403, "message": "Forbidden", "status": 403}`
+	newKey := &Key{SID: "SKnew", FriendlyName: "houston", Secret: "new-secret"}
+
+	testService(t, []serviceTest[rotation]{
+		{
+			call: rotate(old, nil),
+			exchanges: []exchange{
+				create,
+				verify,
+				{method: "DELETE", path: "/x/Keys/SKold.json", user: "SKold", status: http.StatusNoContent},
+			},
+			out:  rotation{key: newKey, sid: "SKnew", secret: "new-secret"},
+			desc: `the client should switch to the new key, and the old key should be deleted`,
+		},
+		{
+			call: rotate(old, nil),
+			exchanges: []exchange{
+				create,
+				{
+					method: "GET", path: "/x/Messages.json", form: form("PageSize", "1"), user: "SKnew",
+					status: http.StatusUnauthorized, body: `{"code": 20003, "message": "Authenticate", "status": 401}`,
+				},
+				{method: "DELETE", path: "/x/Keys/SKnew.json", user: "SKold", status: http.StatusNoContent},
+			},
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `a new key that fails verification should be deleted, and the client should keep the old key`,
+		},
+		{
+			call: rotate(old, nil),
+			exchanges: []exchange{
+				create,
+				verify,
+				{method: "DELETE", path: "/x/Keys/SKold.json", user: "SKold", status: http.StatusForbidden, body: forbidden},
+			},
+			out:  rotation{key: newKey, failed: true, sid: "SKnew", secret: "new-secret"},
+			desc: `failing to delete the old key should return the new key, which the client should keep using`,
+		},
+		{
+			call: rotate(old, nil),
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Keys.json", form: form("FriendlyName", "houston"), user: "SKold",
+					status: http.StatusForbidden, body: forbidden,
+				},
+			},
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `a Standard key should fail to create the new key`,
+		},
+		{
+			call: rotate(Key{SID: "SKother"}, nil),
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `a key the client does not use should be rejected`,
+		},
+		{
+			call: rotate(Key{}, nil),
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `an empty key sid should be rejected`,
+		},
+		{
+			call: rotate(old, func(c *Client) *Client {
+				c.AccountSID = ""
+				return c
+			}),
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `a client without AccountSID should be rejected`,
+		},
+		{
+			call: rotate(old, func(c *Client) *Client {
+				sc, _ := c.Subaccount("AC2")
+				return sc
+			}),
+			out:  rotation{failed: true, sid: "SKold", secret: "old-secret"},
+			desc: `a subaccount client should be rejected`,
+		},
+	})
+}
@@ -0,0 +1,278 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// bucketIdleTime is how long a token bucket must go unused, after refilling to
+// its burst, before a TokenBucketLimiter drops it. It is also the interval at
+// which the limiter looks for such buckets.
+const bucketIdleTime = time.Minute
+
+// Limiter is the interface used by the Client to keep requests under the rate
+// limits enforced by Twilio. Wait is called before every attempt of every
+// request, and should block until the request may be sent.
+type Limiter interface {
+	// Wait blocks until a request may be sent on behalf of the account. The
+	// sender is the From number of the request, and is empty for requests
+	// that don't send anything (e.g., fetching a resource). If ctx is done
+	// before the request may proceed, its error is returned.
+	Wait(ctx context.Context, account, sender string) error
+}
+
+// LimiterStats are the wait-time metrics of a TokenBucketLimiter.
+type LimiterStats struct {
+	// Requests is the number of requests that have passed through the
+	// limiter, whether or not they were delayed.
+	Requests uint64
+
+	// Delayed is the number of requests that had to wait.
+	Delayed uint64
+
+	// Canceled is the number of requests whose context was done before they
+	// could proceed.
+	Canceled uint64
+
+	// TotalWait is the sum of the time spent waiting by all requests.
+	TotalWait time.Duration
+
+	// MaxWait is the longest time a single request had to wait.
+	MaxWait time.Duration
+}
+
+// TokenBucketLimiter is a Limiter that uses a token bucket per account SID and
+// a token bucket per sender number. Requests queue in the order they call
+// Wait, so bursts are smoothed out instead of being rejected by Twilio.
+//
+// Buckets that have been idle for a while, and have refilled to their burst,
+// are dropped so that the limiter does not grow with every sender it has ever
+// seen.
+//
+// A TokenBucketLimiter is safe for concurrent use, and may be shared between
+// multiple clients.
+type TokenBucketLimiter struct {
+	accountRate  float64
+	accountBurst int
+	senderRate   float64
+	senderBurst  int
+
+	mu       sync.Mutex
+	accounts map[string]*bucket
+	senders  map[string]*bucket
+	swept    time.Time
+	stats    LimiterStats
+}
+
+// NewTokenBucketLimiter returns a TokenBucketLimiter which allows accountRate
+// requests per second for each account, with bursts of up to accountBurst
+// requests, and senderRate requests per second for each sender number, with
+// bursts of up to senderBurst requests. A rate of zero disables that limit.
+func NewTokenBucketLimiter(accountRate float64, accountBurst int, senderRate float64, senderBurst int) *TokenBucketLimiter {
+	if accountBurst < 1 {
+		accountBurst = 1
+	}
+
+	if senderBurst < 1 {
+		senderBurst = 1
+	}
+
+	return &TokenBucketLimiter{
+		accountRate:  accountRate,
+		accountBurst: accountBurst,
+		senderRate:   senderRate,
+		senderBurst:  senderBurst,
+		accounts:     make(map[string]*bucket),
+		senders:      make(map[string]*bucket),
+	}
+}
+
+// DefaultLimiter returns a TokenBucketLimiter tuned to the default Twilio
+// limits: 100 requests per second per account, and one message per second per
+// long code.
+func DefaultLimiter() *TokenBucketLimiter {
+	return NewTokenBucketLimiter(100, 100, 1, 1)
+}
+
+// Wait implements the Limiter interface.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, account, sender string) error {
+	var ab, sb *bucket
+
+	now := time.Now()
+
+	l.mu.Lock()
+
+	l.evict(now)
+
+	if l.accountRate > 0 {
+		ab = lookupBucket(l.accounts, account, l.accountRate, l.accountBurst, now)
+	}
+
+	if l.senderRate > 0 && len(sender) > 0 {
+		sb = lookupBucket(l.senders, sender, l.senderRate, l.senderBurst, now)
+	}
+
+	d := ab.reserve(now)
+
+	if sd := sb.reserve(now); sd > d {
+		d = sd
+	}
+
+	l.stats.Requests++
+
+	if d > 0 {
+		l.stats.Delayed++
+		l.stats.TotalWait += d
+
+		if d > l.stats.MaxWait {
+			l.stats.MaxWait = d
+		}
+	}
+
+	l.mu.Unlock()
+
+	if err := sleep(ctx, d); err != nil {
+		// give the tokens back, since the request will never be sent
+		l.mu.Lock()
+		ab.cancel()
+		sb.cancel()
+		l.stats.Canceled++
+		l.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// Stats returns a snapshot of the wait-time metrics of the limiter.
+func (l *TokenBucketLimiter) Stats() LimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stats
+}
+
+// evict drops the buckets that are idle at now. To keep Wait cheap, the
+// buckets are only checked once every bucketIdleTime. The caller must hold
+// l.mu.
+func (l *TokenBucketLimiter) evict(now time.Time) {
+	if now.Sub(l.swept) < bucketIdleTime {
+		return
+	}
+
+	l.swept = now
+
+	for _, m := range []map[string]*bucket{l.accounts, l.senders} {
+		for key, b := range m {
+			if b.idle(now) {
+				delete(m, key)
+			}
+		}
+	}
+}
+
+// bucket is a token bucket. Its tokens go negative when requests are queued
+// waiting for tokens to be refilled.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func lookupBucket(m map[string]*bucket, key string, rate float64, burst int, now time.Time) *bucket {
+	b, ok := m[key]
+
+	if !ok {
+		b = &bucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+		m[key] = b
+	}
+
+	return b
+}
+
+// reserve takes a token from the bucket, and returns how long the caller must
+// wait before the token is actually available. A nil bucket never waits.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		b.last = now
+	}
+
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// idle returns whether the bucket has not been used for bucketIdleTime, and
+// has refilled to its burst. An idle bucket can be dropped, as a new bucket
+// would behave the same.
+func (b *bucket) idle(now time.Time) bool {
+	elapsed := now.Sub(b.last)
+
+	if elapsed < bucketIdleTime {
+		return false
+	}
+
+	return b.tokens+elapsed.Seconds()*b.rate >= b.burst
+}
+
+// cancel returns a token taken by reserve.
+func (b *bucket) cancel() {
+	if b != nil {
+		b.tokens++
+	}
+}
+
+// requestSender returns the From parameter of a form-encoded request, which is
+// used as the sender key for a Limiter.
+func requestSender(req *http.Request) string {
+	if req.Method != "POST" || req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+
+	if err != nil {
+		return ""
+	}
+
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+
+	if err != nil {
+		return ""
+	}
+
+	v, err := url.ParseQuery(string(b))
+
+	if err != nil {
+		return ""
+	}
+
+	return v.Get("From")
+}
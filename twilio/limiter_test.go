@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingLimiter is a Limiter that records the keys it was called with.
+type recordingLimiter struct {
+	mu    sync.Mutex
+	calls [][2]string
+}
+
+func (l *recordingLimiter) Wait(ctx context.Context, account, sender string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.calls = append(l.calls, [2]string{account, sender})
+
+	return nil
+}
+
+func Test_bucket_reserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := &bucket{rate: 2, burst: 2, tokens: 2, last: now}
+
+	tests := []struct {
+		at  time.Duration
+		out time.Duration
+	}{
+		{at: 0, out: 0},
+		{at: 0, out: 0},
+		{at: 0, out: 500 * time.Millisecond},
+		{at: 0, out: time.Second},
+		{at: 2 * time.Second, out: 0},
+		{at: 10 * time.Second, out: 0},
+		{at: 10 * time.Second, out: 0},
+		{at: 10 * time.Second, out: 500 * time.Millisecond},
+	}
+
+	for i, tt := range tests {
+		if d := b.reserve(now.Add(tt.at)); d != tt.out {
+			t.Errorf("%d: b.reserve(+%s) = %s; want %s", i, tt.at, d, tt.out)
+		}
+	}
+
+	var nilBucket *bucket
+
+	if d := nilBucket.reserve(now); d != 0 {
+		t.Errorf("nilBucket.reserve() = %s; want 0", d)
+	}
+}
+
+func TestTokenBucketLimiter_evict(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewTokenBucketLimiter(1, 1, 0.001, 1)
+
+	l.accounts["AC1"] = &bucket{rate: 1, burst: 1, tokens: 1, last: now.Add(-2 * bucketIdleTime)}
+	l.senders["idle"] = &bucket{rate: 0.001, burst: 1, tokens: 1, last: now.Add(-2 * bucketIdleTime)}
+	l.senders["recent"] = &bucket{rate: 0.001, burst: 1, tokens: 1, last: now.Add(-time.Second)}
+	l.senders["refilling"] = &bucket{rate: 0.001, burst: 1, tokens: 0, last: now.Add(-2 * bucketIdleTime)}
+
+	l.evict(now)
+
+	if _, ok := l.accounts["AC1"]; ok {
+		t.Error("l.accounts[\"AC1\"] was not evicted")
+	}
+
+	if _, ok := l.senders["idle"]; ok {
+		t.Error("l.senders[\"idle\"] was not evicted")
+	}
+
+	for _, key := range []string{"recent", "refilling"} {
+		if _, ok := l.senders[key]; !ok {
+			t.Errorf("l.senders[%q] was evicted; want it kept", key)
+		}
+	}
+
+	// buckets are only checked once per bucketIdleTime
+	l.senders["idle"] = &bucket{rate: 0.001, burst: 1, tokens: 1, last: now.Add(-2 * bucketIdleTime)}
+	l.evict(now.Add(time.Second))
+
+	if _, ok := l.senders["idle"]; !ok {
+		t.Error("l.senders[\"idle\"] was evicted before bucketIdleTime passed")
+	}
+
+	l.evict(now.Add(bucketIdleTime))
+
+	if _, ok := l.senders["idle"]; ok {
+		t.Error("l.senders[\"idle\"] was not evicted after bucketIdleTime passed")
+	}
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := NewTokenBucketLimiter(0, 0, 1000, 1)
+
+	// different senders should not wait on each other
+	for _, sender := range []string{"+15005550001", "+15005550002", ""} {
+		if err := l.Wait(ctx, "AC1", sender); err != nil {
+			t.Fatalf("l.Wait(ctx, \"AC1\", %q) = %s; want <nil>", sender, err)
+		}
+	}
+
+	if st := l.Stats(); st.Requests != 3 || st.Delayed != 0 {
+		t.Errorf("l.Stats() = %+v; want 3 requests, 0 delayed", st)
+	}
+
+	if err := l.Wait(ctx, "AC1", "+15005550001"); err != nil {
+		t.Fatalf("l.Wait(ctx, \"AC1\", \"+15005550001\") = %s; want <nil>", err)
+	}
+
+	st := l.Stats()
+
+	if st.Delayed != 1 || st.TotalWait <= 0 || st.MaxWait != st.TotalWait {
+		t.Errorf("l.Stats() = %+v; want 1 delayed with a positive wait", st)
+	}
+
+	// a slow bucket should give its token back if the caller gives up
+	l = NewTokenBucketLimiter(0, 0, 0.001, 1)
+	l.Wait(ctx, "AC1", "+15005550001")
+
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(cctx, "AC1", "+15005550001"); err != context.DeadlineExceeded {
+		t.Errorf("l.Wait() = %v; want context.DeadlineExceeded", err)
+	}
+
+	if st := l.Stats(); st.Canceled != 1 {
+		t.Errorf("l.Stats().Canceled = %d; want 1", st.Canceled)
+	}
+
+	if tokens := l.senders["+15005550001"].tokens; tokens < -0.01 || tokens > 0.01 {
+		t.Errorf("tokens = %f; want ~0", tokens)
+	}
+}
+
+func TestClient_send_limiter(t *testing.T) {
+	ctx := context.Background()
+
+	client, done := setUpTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	defer done()
+
+	l := &recordingLimiter{}
+	client.Limiter = l
+
+	if err := client.post(ctx, "/Messages", url.Values{"From": {"+15005550006"}, "To": {"+15005550001"}}, nil); err != nil {
+		t.Fatalf("client.post() = %s; want <nil>", err)
+	}
+
+	if err := client.delete(ctx, "/Messages/SM1"); err != nil {
+		t.Fatalf("client.delete() = %s; want <nil>", err)
+	}
+
+	want := [][2]string{{"x", "+15005550006"}, {"x", ""}}
+
+	if len(l.calls) != len(want) {
+		t.Fatalf("l.calls = %v; want %v", l.calls, want)
+	}
+
+	for i := range want {
+		if l.calls[i] != want[i] {
+			t.Errorf("l.calls[%d] = %v; want %v", i, l.calls[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// MessageStatus is the delivery status of a message.
+type MessageStatus string
+
+// The statuses of a message. Outbound messages move forward through accepted,
+// queued, sending, sent, delivered, and read, though Twilio may skip some of
+// them. They can stop early in failed or undelivered. Inbound messages are
+// receiving, then received.
+const (
+	MessageStatusAccepted    MessageStatus = "accepted"
+	MessageStatusQueued      MessageStatus = "queued"
+	MessageStatusSending     MessageStatus = "sending"
+	MessageStatusSent        MessageStatus = "sent"
+	MessageStatusDelivered   MessageStatus = "delivered"
+	MessageStatusUndelivered MessageStatus = "undelivered"
+	MessageStatusFailed      MessageStatus = "failed"
+	MessageStatusRead        MessageStatus = "read"
+	MessageStatusReceiving   MessageStatus = "receiving"
+	MessageStatusReceived    MessageStatus = "received"
+)
+
+// messageStatusRank orders the statuses of outbound messages by how far along
+// in their lifecycle they are.
+var messageStatusRank = map[MessageStatus]int{
+	MessageStatusAccepted:  1,
+	MessageStatusQueued:    2,
+	MessageStatusSending:   3,
+	MessageStatusSent:      4,
+	MessageStatusDelivered: 5,
+	MessageStatusRead:      6,
+}
+
+// Valid returns whether s is a known MessageStatus.
+func (s MessageStatus) Valid() bool {
+	switch s {
+	case MessageStatusUndelivered, MessageStatusFailed, MessageStatusReceiving, MessageStatusReceived:
+		return true
+	}
+
+	return messageStatusRank[s] > 0
+}
+
+// Terminal returns whether s is a final status, after which the status of the
+// message will not change.
+func (s MessageStatus) Terminal() bool {
+	switch s {
+	case MessageStatusUndelivered, MessageStatusFailed, MessageStatusRead, MessageStatusReceived:
+		return true
+	}
+
+	return false
+}
+
+// CanTransition returns whether a message may move from status s to status to.
+// Messages may only move forward through their lifecycle, and may fail or be
+// undelivered at any point before being delivered.
+func (s MessageStatus) CanTransition(to MessageStatus) bool {
+	if s.Terminal() || !s.Valid() || !to.Valid() {
+		return false
+	}
+
+	if s == MessageStatusReceiving {
+		return to == MessageStatusReceived
+	}
+
+	switch to {
+	case MessageStatusFailed, MessageStatusUndelivered:
+		return s != MessageStatusDelivered
+	}
+
+	return messageStatusRank[to] > messageStatusRank[s]
+}
+
+// MessagesService provides access to the Messages resource of the Twilio API,
+// which is used to send and manage SMS and MMS messages.
+type MessagesService struct {
+	client *Client
+}
+
+// MessageParams are the parameters used to send a new message.
+type MessageParams struct {
+	// The destination phone number, in E.164 format. Required.
+	To string
+
+	// The Twilio phone number or alphanumeric sender ID to send the message
+	// from. Either From or MessagingServiceSID is required.
+	From string
+
+	// The SID of the Messaging Service to send the message from. Either From
+	// or MessagingServiceSID is required.
+	MessagingServiceSID string
+
+	// The text of the message, up to 1600 characters. Either Body or MediaURL
+	// is required.
+	Body string
+
+	// The URLs of media to include with the message, making it an MMS. Up to
+	// 10 URLs may be provided.
+	MediaURL []string
+
+	// The URL Twilio should POST to when the status of the message changes.
+	StatusCallback string
+
+	// How long, in seconds, the message can remain in the outbound queue
+	// before it is discarded. Zero uses the Twilio default.
+	ValidityPeriod int
+}
+
+func (p *MessageParams) validate() error {
+	if p == nil {
+		return errors.New("*MessageParams cannot be nil")
+	}
+
+	if len(p.To) == 0 {
+		return errors.New("To cannot be zero length")
+	}
+
+	if len(p.From) == 0 && len(p.MessagingServiceSID) == 0 {
+		return errors.New("one of From or MessagingServiceSID must be set")
+	}
+
+	if len(p.Body) == 0 && len(p.MediaURL) == 0 {
+		return errors.New("one of Body or MediaURL must be set")
+	}
+
+	return nil
+}
+
+func (p *MessageParams) values() url.Values {
+	v := url.Values{}
+
+	v.Set("To", p.To)
+
+	setString(v, "From", p.From)
+	setString(v, "MessagingServiceSid", p.MessagingServiceSID)
+	setString(v, "Body", p.Body)
+
+	for _, u := range p.MediaURL {
+		v.Add("MediaUrl", u)
+	}
+
+	setString(v, "StatusCallback", p.StatusCallback)
+	setInt(v, "ValidityPeriod", p.ValidityPeriod)
+
+	return v
+}
+
+// MessageListParams are the filters used when listing messages. All fields
+// are optional.
+type MessageListParams struct {
+	// Only show messages sent to this phone number.
+	To string
+
+	// Only show messages sent from this phone number or alphanumeric sender
+	// ID.
+	From string
+
+	// Only show messages sent on this date.
+	DateSent time.Time
+
+	// Only show messages sent on or after this date.
+	DateSentAfter time.Time
+
+	// Only show messages sent on or before this date.
+	DateSentBefore time.Time
+
+	ListOptions
+}
+
+func (p *MessageListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "To", p.To)
+	setString(v, "From", p.From)
+	setDate(v, "DateSent", p.DateSent)
+	setDate(v, "DateSent>", p.DateSentAfter)
+	setDate(v, "DateSent<", p.DateSentBefore)
+
+	return v
+}
+
+// Send creates a new outbound message using the provided parameters.
+func (s *MessagesService) Send(ctx context.Context, params *MessageParams) (*Message, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	msg := &Message{}
+
+	if err := s.client.post(ctx, "/Messages", params.values(), msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Get fetches the message with the given SID.
+func (s *MessagesService) Get(ctx context.Context, sid string) (*Message, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	msg := &Message{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Messages", sid), nil, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// List returns an iterator over the messages matching the filters in params.
+// The params value may be nil to list all messages.
+func (s *MessagesService) List(params *MessageListParams) *Iterator[Message] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Message](s.client, "/Messages", "messages", params.values(), opts)
+}
+
+// Redact removes the body of the message with the given SID, while keeping
+// the rest of its record. The message must have finished sending.
+func (s *MessagesService) Redact(ctx context.Context, sid string) (*Message, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	msg := &Message{}
+
+	if err := s.client.post(ctx, resourcePath("Messages", sid), url.Values{"Body": {""}}, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Delete removes the message with the given SID, and any media attached to
+// it. The message must have finished sending.
+func (s *MessagesService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("Messages", sid))
+}
+
+// Media returns a MessageMediaService for the media attached to the message
+// with the given SID.
+func (s *MessagesService) Media(messageSID string) *MessageMediaService {
+	return &MessageMediaService{client: s.client, messageSID: messageSID}
+}
+
+// MessageMediaService provides access to the Media subresource of a message,
+// which holds the media files attached to an MMS message.
+type MessageMediaService struct {
+	client     *Client
+	messageSID string
+}
+
+func (s *MessageMediaService) resource() (string, error) {
+	if len(s.messageSID) == 0 {
+		return "", errors.New("message sid cannot be zero length")
+	}
+
+	return resourcePath("Messages", s.messageSID, "Media"), nil
+}
+
+// List returns an iterator over the media attached to the message.
+func (s *MessageMediaService) List(opts ListOptions) *Iterator[Media] {
+	resource, err := s.resource()
+
+	if err != nil {
+		return errIterator[Media](err)
+	}
+
+	return newIterator[Media](s.client, resource, "media_list", nil, opts)
+}
+
+// Get fetches the media with the given SID.
+func (s *MessageMediaService) Get(ctx context.Context, sid string) (*Media, error) {
+	resource, err := s.resource()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	media := &Media{}
+
+	if err = s.client.getJSON(ctx, resource+resourcePath(sid), nil, media); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// Delete removes the media with the given SID.
+func (s *MessageMediaService) Delete(ctx context.Context, sid string) error {
+	resource, err := s.resource()
+
+	if err != nil {
+		return err
+	}
+
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resource+resourcePath(sid))
+}
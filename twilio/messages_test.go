@@ -0,0 +1,295 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testMessageJSON = `{
+	"sid": "SM123",
+	"account_sid": "x",
+	"from": "+15005550006",
+	"to": "+15005550001",
+	"body": "page: db01 is on fire",
+	"num_media": "2",
+	"status": "queued",
+	"error_code": null,
+	"direction": "outbound-api",
+	"price": null
+}`
+
+// testMessage is the Message encoded by testMessageJSON.
+var testMessage = Message{
+	SID:        "SM123",
+	AccountSID: "x",
+	From:       "+15005550006",
+	To:         "+15005550001",
+	Body:       "page: db01 is on fire",
+	NumMedia:   "2",
+	Status:     MessageStatusQueued,
+	Direction:  "outbound-api",
+}
+
+func TestMessagesService_Send(t *testing.T) {
+	send := func(params *MessageParams) func(ctx context.Context, c *Client) (*Message, error) {
+		return func(ctx context.Context, c *Client) (*Message, error) {
+			return c.Messages.Send(ctx, params)
+		}
+	}
+
+	testService(t, []serviceTest[*Message]{
+		{
+			call: send(&MessageParams{
+				To:             "+15005550001",
+				From:           "+15005550006",
+				Body:           "page: db01 is on fire",
+				MediaURL:       []string{"https://example.org/1.png", "https://example.org/2.png"},
+				ValidityPeriod: 60,
+			}),
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Messages.json",
+					form: form(
+						"To", "+15005550001", "From", "+15005550006", "Body", "page: db01 is on fire",
+						"MediaUrl", "https://example.org/1.png", "MediaUrl", "https://example.org/2.png", "ValidityPeriod", "60",
+					),
+					status: http.StatusCreated, body: testMessageJSON,
+				},
+			},
+			out:  &testMessage,
+			desc: `the message should be sent`,
+		},
+		{
+			call: send(&MessageParams{To: "+15005550001", MessagingServiceSID: "MG1", Body: "x"}),
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Messages.json", form: form("To", "+15005550001", "MessagingServiceSid", "MG1", "Body", "x"),
+					status: http.StatusCreated, body: `{"sid": "SM124"}`,
+				},
+			},
+			out:  &Message{SID: "SM124"},
+			desc: `a messaging service should be usable instead of From`,
+		},
+		{
+			call: send(&MessageParams{To: "+15005550001", From: "+15005550006", Body: "x"}),
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Messages.json", form: form("To", "+15005550001", "From", "+15005550006", "Body", "x"),
+					status: http.StatusBadRequest, body: `{"code": 21602, "message": "bad params", "status": 400}`,
+				},
+			},
+			err:  true,
+			desc: `an error response should be returned as an error`,
+		},
+		{
+			call: send(nil),
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: send(&MessageParams{From: "+15005550006", Body: "x"}),
+			err:  true,
+			desc: `an empty To should be rejected`,
+		},
+		{
+			call: send(&MessageParams{To: "+15005550001", Body: "x"}),
+			err:  true,
+			desc: `setting neither From nor MessagingServiceSID should be rejected`,
+		},
+		{
+			call: send(&MessageParams{To: "+15005550001", From: "+15005550006"}),
+			err:  true,
+			desc: `setting neither Body nor MediaURL should be rejected`,
+		},
+	})
+}
+
+func TestMessagesService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Message]{
+		{
+			call: func(ctx context.Context, c *Client) (*Message, error) {
+				return c.Messages.Get(ctx, "SM123")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Messages/SM123.json", body: testMessageJSON},
+			},
+			out:  &testMessage,
+			desc: `the message should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Message, error) {
+				return c.Messages.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestMessagesService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Message]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Message, error) {
+				return collect(ctx, c.Messages.List(&MessageListParams{
+					From:           "+15005550006",
+					DateSentBefore: time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+				}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Messages.json", form: form("From", "+15005550006", "DateSent<", "2017-03-01"),
+					body: `{"messages": [` + testMessageJSON + `], "next_page_uri": null}`,
+				},
+			},
+			out:  []Message{testMessage},
+			desc: `messages should be filtered by sender and date sent`,
+		},
+	})
+}
+
+func TestMessagesService_Redact(t *testing.T) {
+	testService(t, []serviceTest[*Message]{
+		{
+			call: func(ctx context.Context, c *Client) (*Message, error) {
+				return c.Messages.Redact(ctx, "SM123")
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Messages/SM123.json", form: form("Body", ""), body: `{"sid": "SM123", "body": ""}`},
+			},
+			out:  &Message{SID: "SM123"},
+			desc: `the body of the message should be set to the empty string`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Message, error) {
+				return c.Messages.Redact(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestMessagesService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Messages.Delete(ctx, "SM123")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Messages/SM123.json", status: http.StatusNoContent},
+			},
+			desc: `the message should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Messages.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestMessageMediaService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Media]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Media, error) {
+				return collect(ctx, c.Messages.Media("SM123").List(ListOptions{}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Messages/SM123/Media.json",
+					body: `{"media_list": [{"sid": "ME1", "parent_sid": "SM123", "content_type": "image/png"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Media{{SID: "ME1", ParentSID: "SM123", ContentType: "image/png"}},
+			desc: `the media of the message should be listed from the media_list field`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Media, error) {
+				return collect(ctx, c.Messages.Media("").List(ListOptions{}))
+			},
+			err:  true,
+			desc: `an empty message sid should be rejected`,
+		},
+	})
+}
+
+func TestMessageMediaService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Media]{
+		{
+			call: func(ctx context.Context, c *Client) (*Media, error) {
+				return c.Messages.Media("SM123").Get(ctx, "ME1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Messages/SM123/Media/ME1.json", body: `{"sid": "ME1", "parent_sid": "SM123", "content_type": "image/png"}`},
+			},
+			out:  &Media{SID: "ME1", ParentSID: "SM123", ContentType: "image/png"},
+			desc: `the media should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Media, error) {
+				return c.Messages.Media("SM123").Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestMessageMediaService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Messages.Media("SM123").Delete(ctx, "ME1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Messages/SM123/Media/ME1.json", status: http.StatusNoContent},
+			},
+			desc: `the media should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Messages.Media("").Delete(ctx, "ME1")
+			},
+			err:  true,
+			desc: `an empty message sid should be rejected`,
+		},
+	})
+}
+
+func TestMessageStatus_CanTransition(t *testing.T) {
+	tests := []struct {
+		from, to MessageStatus
+		ok       bool
+	}{
+		{MessageStatusQueued, MessageStatusSending, true},
+		{MessageStatusQueued, MessageStatusSent, true},
+		{MessageStatusSent, MessageStatusDelivered, true},
+		{MessageStatusSent, MessageStatusUndelivered, true},
+		{MessageStatusSending, MessageStatusFailed, true},
+		{MessageStatusDelivered, MessageStatusRead, true},
+		{MessageStatusReceiving, MessageStatusReceived, true},
+		{MessageStatusSent, MessageStatusQueued, false},
+		{MessageStatusSent, MessageStatusSent, false},
+		{MessageStatusDelivered, MessageStatusFailed, false},
+		{MessageStatusFailed, MessageStatusDelivered, false},
+		{MessageStatusRead, MessageStatusDelivered, false},
+		{MessageStatusReceiving, MessageStatusSent, false},
+		{MessageStatusQueued, "bogus", false},
+	}
+
+	for _, tt := range tests {
+		if ok := tt.from.CanTransition(tt.to); ok != tt.ok {
+			t.Errorf("%q.CanTransition(%q) = %t; want %t", tt.from, tt.to, ok, tt.ok)
+		}
+	}
+}
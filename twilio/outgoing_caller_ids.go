@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// OutgoingCallerIDsService provides access to the OutgoingCallerIds resource
+// of the Twilio API, which is used to manage verified caller IDs. Trial
+// accounts may only call and message verified phone numbers.
+type OutgoingCallerIDsService struct {
+	client *Client
+}
+
+// OutgoingCallerIDListParams are the filters used when listing caller IDs. All
+// fields are optional.
+type OutgoingCallerIDListParams struct {
+	// Only show the caller ID with this phone number.
+	PhoneNumber string
+
+	// Only show caller IDs with this friendly name.
+	FriendlyName string
+
+	ListOptions
+}
+
+func (p *OutgoingCallerIDListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "PhoneNumber", p.PhoneNumber)
+	setString(v, "FriendlyName", p.FriendlyName)
+
+	return v
+}
+
+// Get fetches the caller ID with the given SID.
+func (s *OutgoingCallerIDsService) Get(ctx context.Context, sid string) (*OutgoingCallerID, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	id := &OutgoingCallerID{}
+
+	if err := s.client.getJSON(ctx, resourcePath("OutgoingCallerIds", sid), nil, id); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// List returns an iterator over the caller IDs matching the filters in params.
+// The params value may be nil to list all caller IDs.
+func (s *OutgoingCallerIDsService) List(params *OutgoingCallerIDListParams) *Iterator[OutgoingCallerID] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[OutgoingCallerID](s.client, "/OutgoingCallerIds", "outgoing_caller_ids", params.values(), opts)
+}
+
+// Update changes the friendly name of the caller ID with the given SID.
+func (s *OutgoingCallerIDsService) Update(ctx context.Context, sid, friendlyName string) (*OutgoingCallerID, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if len(friendlyName) == 0 {
+		return nil, errors.New("friendlyName cannot be zero length")
+	}
+
+	v := url.Values{}
+	v.Set("FriendlyName", friendlyName)
+
+	id := &OutgoingCallerID{}
+
+	if err := s.client.post(ctx, resourcePath("OutgoingCallerIds", sid), v, id); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// Delete removes the caller ID with the given SID. The phone number will need
+// to be verified again before it can be used.
+func (s *OutgoingCallerIDsService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("OutgoingCallerIds", sid))
+}
+
+// ValidationRequestsService is used to start the verification of new caller
+// IDs. Once verified, they are available from OutgoingCallerIDsService.
+type ValidationRequestsService struct {
+	client *Client
+}
+
+// ValidationRequestParams are the parameters used to start verifying a caller
+// ID. PhoneNumber is required.
+type ValidationRequestParams struct {
+	// The phone number to verify, in E.164 format.
+	PhoneNumber string
+
+	// A human readable description for the new caller ID.
+	FriendlyName string
+
+	// The number of seconds to wait before placing the verification call.
+	CallDelay int
+
+	// The digits to dial after the call connects, to reach an extension.
+	Extension string
+
+	// The URL Twilio will send the result of the verification to.
+	StatusCallback string
+
+	// The HTTP method Twilio should use when requesting StatusCallback.
+	StatusCallbackMethod string
+}
+
+func (p *ValidationRequestParams) values() url.Values {
+	v := url.Values{}
+
+	v.Set("PhoneNumber", p.PhoneNumber)
+
+	setString(v, "FriendlyName", p.FriendlyName)
+	setInt(v, "CallDelay", p.CallDelay)
+	setString(v, "Extension", p.Extension)
+	setString(v, "StatusCallback", p.StatusCallback)
+	setString(v, "StatusCallbackMethod", p.StatusCallbackMethod)
+
+	return v
+}
+
+// Create starts verifying a caller ID by placing a call to its phone number.
+// The returned ValidationCode must be entered by whoever answers the call.
+func (s *ValidationRequestsService) Create(ctx context.Context, params *ValidationRequestParams) (*ValidationRequest, error) {
+	if params == nil {
+		return nil, errors.New("*ValidationRequestParams cannot be nil")
+	}
+
+	if len(params.PhoneNumber) == 0 {
+		return nil, errors.New("PhoneNumber cannot be zero length")
+	}
+
+	vr := &ValidationRequest{}
+
+	if err := s.client.post(ctx, "/OutgoingCallerIds", params.values(), vr); err != nil {
+		return nil, err
+	}
+
+	return vr, nil
+}
@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestValidationRequestsService_Create(t *testing.T) {
+	testService(t, []serviceTest[*ValidationRequest]{
+		{
+			call: func(ctx context.Context, c *Client) (*ValidationRequest, error) {
+				return c.ValidationRequests.Create(ctx, &ValidationRequestParams{
+					PhoneNumber:  "+15005550006",
+					FriendlyName: "Jane",
+					CallDelay:    5,
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/OutgoingCallerIds.json", form: form("PhoneNumber", "+15005550006", "FriendlyName", "Jane", "CallDelay", "5"),
+					body: `{"call_sid": "CA1", "phone_number": "+15005550006", "friendly_name": "Jane", "validation_code": "123456"}`,
+				},
+			},
+			out:  &ValidationRequest{CallSID: "CA1", PhoneNumber: "+15005550006", FriendlyName: "Jane", ValidationCode: "123456"},
+			desc: `the validation call should be started, returning the code`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*ValidationRequest, error) {
+				return c.ValidationRequests.Create(ctx, &ValidationRequestParams{})
+			},
+			err:  true,
+			desc: `an empty PhoneNumber should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*ValidationRequest, error) {
+				return c.ValidationRequests.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestOutgoingCallerIDsService_List(t *testing.T) {
+	testService(t, []serviceTest[[]OutgoingCallerID]{
+		{
+			call: func(ctx context.Context, c *Client) ([]OutgoingCallerID, error) {
+				return collect(ctx, c.OutgoingCallerIDs.List(&OutgoingCallerIDListParams{PhoneNumber: "+15005550006"}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/OutgoingCallerIds.json", form: form("PhoneNumber", "+15005550006"),
+					body: `{"outgoing_caller_ids": [{"sid": "PN1", "phone_number": "+15005550006"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []OutgoingCallerID{{SID: "PN1", PhoneNumber: "+15005550006"}},
+			desc: `caller ids should be filtered by phone number`,
+		},
+	})
+}
+
+func TestOutgoingCallerIDsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*OutgoingCallerID]{
+		{
+			call: func(ctx context.Context, c *Client) (*OutgoingCallerID, error) {
+				return c.OutgoingCallerIDs.Get(ctx, "PN1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/OutgoingCallerIds/PN1.json", body: `{"sid": "PN1", "phone_number": "+15005550006", "friendly_name": "Jane"}`},
+			},
+			out:  &OutgoingCallerID{SID: "PN1", PhoneNumber: "+15005550006", FriendlyName: "Jane"},
+			desc: `the caller id should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*OutgoingCallerID, error) {
+				return c.OutgoingCallerIDs.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestOutgoingCallerIDsService_Update(t *testing.T) {
+	testService(t, []serviceTest[*OutgoingCallerID]{
+		{
+			call: func(ctx context.Context, c *Client) (*OutgoingCallerID, error) {
+				return c.OutgoingCallerIDs.Update(ctx, "PN1", "Jane (personal)")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/OutgoingCallerIds/PN1.json", form: form("FriendlyName", "Jane (personal)"),
+					body: `{"sid": "PN1", "friendly_name": "Jane (personal)"}`,
+				},
+			},
+			out:  &OutgoingCallerID{SID: "PN1", FriendlyName: "Jane (personal)"},
+			desc: `the caller id should be renamed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*OutgoingCallerID, error) {
+				return c.OutgoingCallerIDs.Update(ctx, "PN1", "")
+			},
+			err:  true,
+			desc: `an empty friendly name should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*OutgoingCallerID, error) {
+				return c.OutgoingCallerIDs.Update(ctx, "", "Jane (personal)")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestOutgoingCallerIDsService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.OutgoingCallerIDs.Delete(ctx, "PN1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/OutgoingCallerIds/PN1.json", status: http.StatusNoContent},
+			},
+			desc: `the caller id should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.OutgoingCallerIDs.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// QueueMemberFront is the identifier of the member at the front of a queue,
+// and may be used in place of a call SID.
+const QueueMemberFront = "Front"
+
+// QueuesService provides access to the Queues resource of the Twilio API,
+// which is used to manage queues of calls waiting to be connected.
+type QueuesService struct {
+	client *Client
+}
+
+// QueueParams are the parameters used to create or update a queue.
+type QueueParams struct {
+	// A human readable description of the queue. Required when creating a
+	// queue.
+	FriendlyName string
+
+	// The maximum number of calls allowed in the queue. Twilio defaults this
+	// to 100 when creating a queue; the limit is 5000.
+	MaxSize int
+}
+
+func (p *QueueParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "FriendlyName", p.FriendlyName)
+	setInt(v, "MaxSize", p.MaxSize)
+
+	return v
+}
+
+// Create creates a new queue.
+func (s *QueuesService) Create(ctx context.Context, params *QueueParams) (*Queue, error) {
+	if params == nil {
+		return nil, errors.New("*QueueParams cannot be nil")
+	}
+
+	if len(params.FriendlyName) == 0 {
+		return nil, errors.New("FriendlyName cannot be zero length")
+	}
+
+	q := &Queue{}
+
+	if err := s.client.post(ctx, "/Queues", params.values(), q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// Get fetches the queue with the given SID.
+func (s *QueuesService) Get(ctx context.Context, sid string) (*Queue, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	q := &Queue{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Queues", sid), nil, q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// List returns an iterator over the account's queues.
+func (s *QueuesService) List(opts ListOptions) *Iterator[Queue] {
+	return newIterator[Queue](s.client, "/Queues", "queues", nil, opts)
+}
+
+// Update modifies the queue with the given SID. Only the fields of params that
+// are set are changed.
+func (s *QueuesService) Update(ctx context.Context, sid string, params *QueueParams) (*Queue, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*QueueParams cannot be nil")
+	}
+
+	q := &Queue{}
+
+	if err := s.client.post(ctx, resourcePath("Queues", sid), params.values(), q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// SetMaxSize changes the maximum number of calls allowed in the queue.
+func (s *QueuesService) SetMaxSize(ctx context.Context, sid string, maxSize int) (*Queue, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("maxSize must be positive")
+	}
+
+	return s.Update(ctx, sid, &QueueParams{MaxSize: maxSize})
+}
+
+// Delete removes the queue with the given SID. Twilio refuses to delete a
+// queue that has calls waiting in it.
+func (s *QueuesService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("Queues", sid))
+}
+
+// Members returns a QueueMembersService for the calls waiting in the queue with
+// the given SID.
+func (s *QueuesService) Members(queueSID string) *QueueMembersService {
+	return &QueueMembersService{client: s.client, queue: queueSID}
+}
+
+// QueueMembersService provides access to the Members subresource of a queue.
+type QueueMembersService struct {
+	client *Client
+	queue  string
+}
+
+func (s *QueueMembersService) resource(callSID string) (string, error) {
+	if len(s.queue) == 0 {
+		return "", errors.New("queue sid cannot be zero length")
+	}
+
+	if len(callSID) == 0 {
+		return resourcePath("Queues", s.queue, "Members"), nil
+	}
+
+	return resourcePath("Queues", s.queue, "Members", callSID), nil
+}
+
+// List returns an iterator over the calls waiting in the queue.
+func (s *QueueMembersService) List(opts ListOptions) *Iterator[QueueMember] {
+	resource, err := s.resource("")
+
+	if err != nil {
+		return errIterator[QueueMember](err)
+	}
+
+	return newIterator[QueueMember](s.client, resource, "queue_members", nil, opts)
+}
+
+// Get fetches the member with the given call SID. QueueMemberFront may be used
+// to fetch the call at the front of the queue.
+func (s *QueueMembersService) Get(ctx context.Context, callSID string) (*QueueMember, error) {
+	if len(callSID) == 0 {
+		return nil, errors.New("call sid cannot be zero length")
+	}
+
+	resource, err := s.resource(callSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := &QueueMember{}
+
+	if err = s.client.getJSON(ctx, resource, nil, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Front fetches the call at the front of the queue.
+func (s *QueueMembersService) Front(ctx context.Context) (*QueueMember, error) {
+	return s.Get(ctx, QueueMemberFront)
+}
+
+// Dequeue removes the call with the given SID from the queue, and redirects it
+// to the TwiML at urlStr. The method is the HTTP method Twilio uses to request
+// urlStr, and defaults to POST if empty. QueueMemberFront may be used to
+// dequeue the call at the front of the queue.
+func (s *QueueMembersService) Dequeue(ctx context.Context, callSID, urlStr, method string) (*QueueMember, error) {
+	if len(callSID) == 0 {
+		return nil, errors.New("call sid cannot be zero length")
+	}
+
+	if len(urlStr) == 0 {
+		return nil, errors.New("url cannot be zero length")
+	}
+
+	resource, err := s.resource(callSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("Url", urlStr)
+	setString(v, "Method", method)
+
+	m := &QueueMember{}
+
+	if err = s.client.post(ctx, resource, v, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestQueuesService_Create(t *testing.T) {
+	testService(t, []serviceTest[*Queue]{
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Create(ctx, &QueueParams{FriendlyName: "hotline"})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Queues.json", form: form("FriendlyName", "hotline"),
+					status: http.StatusCreated, body: `{"sid": "QU1", "friendly_name": "hotline", "max_size": 100}`,
+				},
+			},
+			out:  &Queue{SID: "QU1", FriendlyName: "hotline", MaxSize: 100},
+			desc: `the queue should be created`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Create(ctx, &QueueParams{MaxSize: 10})
+			},
+			err:  true,
+			desc: `an empty FriendlyName should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestQueuesService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Queue]{
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Get(ctx, "QU1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Queues/QU1.json", body: `{"sid": "QU1", "current_size": 2, "average_wait_time": 45}`},
+			},
+			out:  &Queue{SID: "QU1", CurrentSize: 2, AverageWaitTime: 45},
+			desc: `the queue should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestQueuesService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Queue]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Queue, error) {
+				return collect(ctx, c.Queues.List(ListOptions{}))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Queues.json", body: `{"queues": [{"sid": "QU1"}, {"sid": "QU2"}], "next_page_uri": null}`},
+			},
+			out:  []Queue{{SID: "QU1"}, {SID: "QU2"}},
+			desc: `all queues should be listed`,
+		},
+	})
+}
+
+func TestQueuesService_Update(t *testing.T) {
+	testService(t, []serviceTest[*Queue]{
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Update(ctx, "QU1", &QueueParams{FriendlyName: "hotline-eu"})
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Queues/QU1.json", form: form("FriendlyName", "hotline-eu"), body: `{"sid": "QU1", "friendly_name": "hotline-eu"}`},
+			},
+			out:  &Queue{SID: "QU1", FriendlyName: "hotline-eu"},
+			desc: `the queue should be renamed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Update(ctx, "QU1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.Update(ctx, "", &QueueParams{FriendlyName: "hotline-eu"})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestQueuesService_SetMaxSize(t *testing.T) {
+	testService(t, []serviceTest[*Queue]{
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.SetMaxSize(ctx, "QU1", 250)
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Queues/QU1.json", form: form("MaxSize", "250"), body: `{"sid": "QU1", "max_size": 250}`},
+			},
+			out:  &Queue{SID: "QU1", MaxSize: 250},
+			desc: `only the max size should be sent`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Queue, error) {
+				return c.Queues.SetMaxSize(ctx, "QU1", 0)
+			},
+			err:  true,
+			desc: `a max size that is not positive should be rejected`,
+		},
+	})
+}
+
+func TestQueuesService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Queues.Delete(ctx, "QU1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Queues/QU1.json", status: http.StatusNoContent},
+			},
+			desc: `the queue should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Queues.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestQueueMembersService_List(t *testing.T) {
+	testService(t, []serviceTest[[]QueueMember]{
+		{
+			call: func(ctx context.Context, c *Client) ([]QueueMember, error) {
+				return collect(ctx, c.Queues.Members("QU1").List(ListOptions{}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Queues/QU1/Members.json",
+					body: `{"queue_members": [{"call_sid": "CA1", "position": 1}, {"call_sid": "CA2", "position": 2}], "next_page_uri": null}`,
+				},
+			},
+			out:  []QueueMember{{CallSID: "CA1", Position: 1}, {CallSID: "CA2", Position: 2}},
+			desc: `the members of the queue should be listed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]QueueMember, error) {
+				return collect(ctx, c.Queues.Members("").List(ListOptions{}))
+			},
+			err:  true,
+			desc: `an empty queue sid should be rejected`,
+		},
+	})
+}
+
+func TestQueueMembersService_Get(t *testing.T) {
+	testService(t, []serviceTest[*QueueMember]{
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Get(ctx, "CA2")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Queues/QU1/Members/CA2.json", body: `{"call_sid": "CA2", "queue_sid": "QU1", "position": 2}`},
+			},
+			out:  &QueueMember{CallSID: "CA2", QueueSID: "QU1", Position: 2},
+			desc: `the member should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
+
+func TestQueueMembersService_Front(t *testing.T) {
+	testService(t, []serviceTest[*QueueMember]{
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Front(ctx)
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Queues/QU1/Members/Front.json", body: `{"call_sid": "CA1", "queue_sid": "QU1", "position": 1, "wait_time": 30}`},
+			},
+			out:  &QueueMember{CallSID: "CA1", QueueSID: "QU1", Position: 1, WaitTime: 30},
+			desc: `the member at the front of the queue should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("").Front(ctx)
+			},
+			err:  true,
+			desc: `an empty queue sid should be rejected`,
+		},
+	})
+}
+
+func TestQueueMembersService_Dequeue(t *testing.T) {
+	testService(t, []serviceTest[*QueueMember]{
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Dequeue(ctx, "CA2", "https://example.org/connect", "")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Queues/QU1/Members/CA2.json", form: form("Url", "https://example.org/connect"),
+					body: `{"call_sid": "CA2", "queue_sid": "QU1", "position": 2}`,
+				},
+			},
+			out:  &QueueMember{CallSID: "CA2", QueueSID: "QU1", Position: 2},
+			desc: `the member should be redirected to the url`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Dequeue(ctx, QueueMemberFront, "https://example.org/connect", "GET")
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Queues/QU1/Members/Front.json", form: form("Url", "https://example.org/connect", "Method", "GET"),
+					body: `{"call_sid": "CA1", "queue_sid": "QU1", "position": 1}`,
+				},
+			},
+			out:  &QueueMember{CallSID: "CA1", QueueSID: "QU1", Position: 1},
+			desc: `the member at the front should be dequeued with the method`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Dequeue(ctx, "CA2", "", "")
+			},
+			err:  true,
+			desc: `an empty url should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*QueueMember, error) {
+				return c.Queues.Members("QU1").Dequeue(ctx, "", "https://example.org/connect", "")
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
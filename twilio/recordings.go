@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// RecordingFormat is the audio format a recording can be downloaded in.
+type RecordingFormat string
+
+// The formats a recording can be downloaded in.
+const (
+	RecordingFormatWAV RecordingFormat = "wav"
+	RecordingFormatMP3 RecordingFormat = "mp3"
+)
+
+// RecordingsService provides access to the Recordings resource of the Twilio
+// API, either for the whole account or for a single call.
+type RecordingsService struct {
+	client *Client
+
+	// prefix is the resource the recordings are nested under, and is empty
+	// for the account level.
+	prefix string
+}
+
+// RecordingListParams are the filters used when listing recordings. All fields
+// are optional.
+type RecordingListParams struct {
+	// Only show recordings created on this date.
+	DateCreated time.Time
+
+	// Only show recordings created on or after this date.
+	DateCreatedAfter time.Time
+
+	// Only show recordings created on or before this date.
+	DateCreatedBefore time.Time
+
+	// Only show recordings made during this call. Ignored when listing the
+	// recordings of a call.
+	CallSID string
+
+	// Only show recordings made during this conference.
+	ConferenceSID string
+
+	ListOptions
+}
+
+func (p *RecordingListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setDate(v, "DateCreated", p.DateCreated)
+	setDate(v, "DateCreated>", p.DateCreatedAfter)
+	setDate(v, "DateCreated<", p.DateCreatedBefore)
+	setString(v, "CallSid", p.CallSID)
+	setString(v, "ConferenceSid", p.ConferenceSID)
+
+	return v
+}
+
+// Recordings returns a RecordingsService for the recordings of the call with
+// the given SID.
+func (s *CallsService) Recordings(callSID string) *RecordingsService {
+	return &RecordingsService{client: s.client, prefix: resourcePath("Calls", callSID)}
+}
+
+func (s *RecordingsService) resource(sid string) (string, error) {
+	if s.prefix == "/Calls/" {
+		return "", errors.New("call sid cannot be zero length")
+	}
+
+	if len(sid) == 0 {
+		return s.prefix + "/Recordings", nil
+	}
+
+	return s.prefix + resourcePath("Recordings", sid), nil
+}
+
+// List returns an iterator over the recordings matching the filters in
+// params. The params value may be nil to list all recordings.
+func (s *RecordingsService) List(params *RecordingListParams) *Iterator[Recording] {
+	resource, err := s.resource("")
+
+	if err != nil {
+		return errIterator[Recording](err)
+	}
+
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[Recording](s.client, resource, "recordings", params.values(), opts)
+}
+
+// Get fetches the recording with the given SID.
+func (s *RecordingsService) Get(ctx context.Context, sid string) (*Recording, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	resource, err := s.resource(sid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &Recording{}
+
+	if err = s.client.getJSON(ctx, resource, nil, rec); err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// Delete removes the recording with the given SID, along with its audio.
+func (s *RecordingsService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	resource, err := s.resource(sid)
+
+	if err != nil {
+		return err
+	}
+
+	return s.client.delete(ctx, resource)
+}
+
+// Download fetches the audio of the recording with the given SID in the given
+// format. The caller must close the returned io.ReadCloser.
+func (s *RecordingsService) Download(ctx context.Context, sid string, format RecordingFormat) (io.ReadCloser, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	switch format {
+	case RecordingFormatWAV, RecordingFormatMP3:
+	default:
+		return nil, fmt.Errorf("unknown RecordingFormat %q", format)
+	}
+
+	// the audio is only served from the account level, whatever the
+	// recording is nested under
+	req, err := newURLRequest(ctx, s.client, "GET", s.client.formatURL(resourcePath("Recordings", sid), string(format)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "audio/*")
+
+	resp, err := s.client.send(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Transcriptions returns a TranscriptionsService for the transcriptions of the
+// recording with the given SID.
+func (s *RecordingsService) Transcriptions(recordingSID string) *TranscriptionsService {
+	return &TranscriptionsService{client: s.client, prefix: resourcePath("Recordings", recordingSID)}
+}
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRecordingsService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Recording]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Recording, error) {
+				return collect(ctx, c.Recordings.List(&RecordingListParams{DateCreatedAfter: time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Recordings.json", form: form("DateCreated>", "2017-03-01"),
+					body: `{"recordings": [{"sid": "RE1"}, {"sid": "RE2"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []Recording{{SID: "RE1"}, {SID: "RE2"}},
+			desc: `recordings should be filtered by creation date`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Recording, error) {
+				return collect(ctx, c.Calls.Recordings("CA1").List(nil))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Calls/CA1/Recordings.json", body: `{"recordings": [{"sid": "RE1", "call_sid": "CA1"}], "next_page_uri": null}`},
+			},
+			out:  []Recording{{SID: "RE1", CallSID: "CA1"}},
+			desc: `the recordings of a call should be listed from within the call`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Recording, error) {
+				return collect(ctx, c.Calls.Recordings("").List(nil))
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
+
+func TestRecordingsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Recording]{
+		{
+			call: func(ctx context.Context, c *Client) (*Recording, error) {
+				return c.Recordings.Get(ctx, "RE1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Recordings/RE1.json", body: `{"sid": "RE1", "call_sid": "CA1", "channels": 1, "duration": "12"}`},
+			},
+			out:  &Recording{SID: "RE1", CallSID: "CA1", Channels: 1, Duration: "12"},
+			desc: `the recording should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Recording, error) {
+				return c.Calls.Recordings("CA1").Get(ctx, "RE1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Calls/CA1/Recordings/RE1.json", body: `{"sid": "RE1", "call_sid": "CA1"}`},
+			},
+			out:  &Recording{SID: "RE1", CallSID: "CA1"},
+			desc: `the recording of a call should be fetched from within the call`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Recording, error) {
+				return c.Calls.Recordings("").Get(ctx, "RE1")
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Recording, error) {
+				return c.Recordings.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestRecordingsService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Recordings.Delete(ctx, "RE1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Recordings/RE1.json", status: http.StatusNoContent},
+			},
+			desc: `the recording should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Recordings.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestRecordingsService_Download(t *testing.T) {
+	// download reads the whole body of the downloaded recording
+	download := func(s func(c *Client) *RecordingsService, sid string, format RecordingFormat) func(ctx context.Context, c *Client) (string, error) {
+		return func(ctx context.Context, c *Client) (string, error) {
+			body, err := s(c).Download(ctx, sid, format)
+
+			if err != nil {
+				return "", err
+			}
+
+			defer body.Close()
+
+			b, err := ioutil.ReadAll(body)
+
+			return string(b), err
+		}
+	}
+
+	recordings := func(c *Client) *RecordingsService { return c.Recordings }
+
+	testService(t, []serviceTest[string]{
+		{
+			call: download(func(c *Client) *RecordingsService { return c.Calls.Recordings("CA1") }, "RE1", RecordingFormatMP3),
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Recordings/RE1.mp3", body: "ID3-audio"},
+			},
+			out:  "ID3-audio",
+			desc: `the media should be downloaded from the account, even for the recording of a call`,
+		},
+		{
+			call: download(recordings, "RE2", RecordingFormatMP3),
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Recordings/RE2.mp3", status: http.StatusNotFound, body: notFound},
+			},
+			err:  true,
+			desc: `a missing recording should return an error`,
+		},
+		{
+			call: download(recordings, "RE1", "ogg"),
+			err:  true,
+			desc: `an unknown format should be rejected`,
+		},
+		{
+			call: download(recordings, "", RecordingFormatMP3),
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
@@ -35,7 +35,7 @@ type Account struct {
 
 	// The status of this account. Usually active, but can be suspended or
 	// closed.
-	Status string `json:"status"`
+	Status AccountStatus `json:"status"`
 
 	// The authorization token for this account. This token should be kept a
 	// secret, so no sharing.
@@ -72,6 +72,10 @@ type Address struct {
 	// The number and street address where you or your customer is located.
 	Street string `json:"street"`
 
+	// The additional number and street address of the address, such as an
+	// apartment or suite number.
+	StreetSecondary string `json:"street_secondary"`
+
 	// The city in which you or your customer is located.
 	City string `json:"city"`
 
@@ -97,4 +101,787 @@ type Address struct {
 	// true if the Address has been validated, or false for countries that don't
 	// require validation or if the Address is non-compliant.
 	Validated bool `json:"validated"`
+
+	// This value will be true if the Address has been verified by Twilio as a
+	// real address.
+	Verified bool `json:"verified"`
+
+	// The date that this address was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this address was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A DependentPhoneNumber is a phone number that depends on an Address, due to
+// the address requirements of the number's country. An Address cannot be
+// deleted while it has dependent phone numbers.
+type DependentPhoneNumber struct {
+	// A 34 character string that uniquely identifies this phone number.
+	SID string `json:"sid"`
+
+	// The unique id of the Account responsible for this phone number.
+	AccountSID string `json:"account_sid"`
+
+	// A human-readable description of the phone number.
+	FriendlyName string `json:"friendly_name"`
+
+	// The phone number, in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+
+	// The address requirement of the phone number. Either none, any, local,
+	// or foreign.
+	AddressRequirements string `json:"address_requirements"`
+
+	// Whether emergency calling is active on the phone number.
+	EmergencyStatus string `json:"emergency_status"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this phone number was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this phone number was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Message instance resource represents an inbound or outbound SMS or MMS
+// message.
+type Message struct {
+	// A 34 character string that uniquely identifies this message.
+	SID string `json:"sid"`
+
+	// The unique id of the Account that sent or received this message.
+	AccountSID string `json:"account_sid"`
+
+	// The unique id of the Messaging Service used with this message, if any.
+	MessagingServiceSID string `json:"messaging_service_sid"`
+
+	// The phone number (in E.164 format) or alphanumeric sender ID that
+	// initiated the message.
+	From string `json:"from"`
+
+	// The phone number (in E.164 format) that received the message.
+	To string `json:"to"`
+
+	// The text body of the message. Up to 1600 characters long.
+	Body string `json:"body"`
+
+	// The number of segments that make up the message. Messages longer than
+	// 160 characters are split in to multiple segments.
+	NumSegments string `json:"num_segments"`
+
+	// The number of media files associated with the message.
+	NumMedia string `json:"num_media"`
+
+	// The status of this message. Either accepted, queued, sending, sent,
+	// failed, delivered, undelivered, receiving, received, or read.
+	Status MessageStatus `json:"status"`
+
+	// The error code, if any, associated with the message. If the message
+	// status is failed or undelivered, this will explain why.
+	ErrorCode int `json:"error_code"`
+
+	// The human readable description of the ErrorCode, if any.
+	ErrorMessage string `json:"error_message"`
+
+	// The direction of this message. inbound for incoming messages,
+	// outbound-api for messages initiated via the REST API, outbound-call for
+	// messages initiated during a call, or outbound-reply for messages
+	// initiated in response to an incoming message.
+	Direction string `json:"direction"`
+
+	// The amount billed for the message, in the currency given by PriceUnit.
+	// This is empty until the message has been priced.
+	Price string `json:"price"`
+
+	// The currency in which Price is measured, in ISO 4217 format (e.g. USD).
+	PriceUnit string `json:"price_unit"`
+
+	// The version of the Twilio API used to process the message.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The list of subresources under this message.
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+
+	// The date that this message was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that the message was sent, or received for inbound messages.
+	DateSent Time `json:"date_sent"`
+
+	// The date that this message was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Call instance resource represents a connection between a telephone and
+// Twilio.
+type Call struct {
+	// A 34 character string that uniquely identifies this call.
+	SID string `json:"sid"`
+
+	// A 34 character string that uniquely identifies the call that created
+	// this leg, if any.
+	ParentCallSID string `json:"parent_call_sid"`
+
+	// The unique id of the Account responsible for this call.
+	AccountSID string `json:"account_sid"`
+
+	// The phone number, SIP address or Client identifier that received this
+	// call.
+	To string `json:"to"`
+
+	// A formatted version of the To phone number.
+	ToFormatted string `json:"to_formatted"`
+
+	// The phone number, SIP address or Client identifier that made this call.
+	From string `json:"from"`
+
+	// A formatted version of the From phone number.
+	FromFormatted string `json:"from_formatted"`
+
+	// If the call was inbound, this is the SID of the IncomingPhoneNumber that
+	// received the call. If the call was outbound, it is the SID of the
+	// OutgoingCallerId from which the call was placed.
+	PhoneNumberSID string `json:"phone_number_sid"`
+
+	// The status of this call. Either queued, ringing, in-progress, canceled,
+	// completed, failed, busy or no-answer.
+	Status CallStatus `json:"status"`
+
+	// The start time of the call. Empty if the call has not yet been dialed.
+	StartTime Time `json:"start_time"`
+
+	// The end time of the call. Empty if the call did not complete
+	// successfully.
+	EndTime Time `json:"end_time"`
+
+	// The length of the call in seconds. This value is empty for busy,
+	// failed, unanswered or ongoing calls.
+	Duration string `json:"duration"`
+
+	// The charge for this call, in the currency given by PriceUnit. This is
+	// empty until the call has been priced.
+	Price string `json:"price"`
+
+	// The currency in which Price is measured, in ISO 4217 format (e.g. USD).
+	PriceUnit string `json:"price_unit"`
+
+	// A string describing the direction of the call. inbound for inbound
+	// calls, outbound-api for calls initiated via the REST API or
+	// outbound-dial for calls initiated by a <Dial> verb.
+	Direction string `json:"direction"`
+
+	// If this call was initiated with answering machine detection, either
+	// human or machine. Empty otherwise.
+	AnsweredBy string `json:"answered_by"`
+
+	// The version of the Twilio API used to process the call.
+	APIVersion string `json:"api_version"`
+
+	// If this call was an incoming call forwarded from another number, the
+	// forwarding phone number (depends on carrier supporting forwarding).
+	ForwardedFrom string `json:"forwarded_from"`
+
+	// If this call was an incoming call to a phone number with Caller ID
+	// Lookup enabled, the caller's name.
+	CallerName string `json:"caller_name"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The list of subresources under this call.
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+
+	// The date that this call was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this call was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// PhoneNumberCapabilities are the types of communication a phone number
+// supports.
+type PhoneNumberCapabilities struct {
+	Voice bool `json:"voice"`
+	SMS   bool `json:"sms"`
+	MMS   bool `json:"mms"`
+	Fax   bool `json:"fax"`
+}
+
+// An IncomingPhoneNumber instance resource represents a Twilio phone number
+// purchased from Twilio or ported to Twilio.
+type IncomingPhoneNumber struct {
+	// A 34 character string that uniquely identifies this phone number.
+	SID string `json:"sid"`
+
+	// The unique id of the Account that owns this phone number.
+	AccountSID string `json:"account_sid"`
+
+	// A human readable descriptive text for this resource, up to 64
+	// characters long. By default, the FriendlyName is a nicely formatted
+	// version of the phone number.
+	FriendlyName string `json:"friendly_name"`
+
+	// The incoming phone number, in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+
+	// The URL Twilio will request when this phone number receives a call.
+	VoiceURL string `json:"voice_url"`
+
+	// The HTTP method Twilio will use when requesting the VoiceURL.
+	VoiceMethod string `json:"voice_method"`
+
+	// The URL that Twilio will request if an error occurs retrieving or
+	// executing the TwiML requested by VoiceURL.
+	VoiceFallbackURL string `json:"voice_fallback_url"`
+
+	// The HTTP method Twilio will use when requesting the VoiceFallbackURL.
+	VoiceFallbackMethod string `json:"voice_fallback_method"`
+
+	// Whether to look up the caller's name from the CNAM database.
+	VoiceCallerIDLookup bool `json:"voice_caller_id_lookup"`
+
+	// The SID of the application that handles calls to this phone number. If
+	// set, the Voice URLs are ignored.
+	VoiceApplicationSID string `json:"voice_application_sid"`
+
+	// The URL Twilio will request when this phone number receives an SMS
+	// message.
+	SmsURL string `json:"sms_url"`
+
+	// The HTTP method Twilio will use when requesting the SmsURL.
+	SmsMethod string `json:"sms_method"`
+
+	// The URL that Twilio will request if an error occurs retrieving or
+	// executing the TwiML requested by SmsURL.
+	SmsFallbackURL string `json:"sms_fallback_url"`
+
+	// The HTTP method Twilio will use when requesting the SmsFallbackURL.
+	SmsFallbackMethod string `json:"sms_fallback_method"`
+
+	// The SID of the application that handles SMS messages sent to this phone
+	// number. If set, the SMS URLs are ignored.
+	SmsApplicationSID string `json:"sms_application_sid"`
+
+	// The URL that Twilio will request to pass status parameters (such as
+	// call ended) to your application.
+	StatusCallback string `json:"status_callback"`
+
+	// The HTTP method Twilio will use when requesting the StatusCallback.
+	StatusCallbackMethod string `json:"status_callback_method"`
+
+	// The SID of the Address resource associated with this phone number.
+	AddressSID string `json:"address_sid"`
+
+	// The address requirement of this phone number. Either none, any, local,
+	// or foreign.
+	AddressRequirements string `json:"address_requirements"`
+
+	// The types of communication this phone number supports.
+	Capabilities PhoneNumberCapabilities `json:"capabilities"`
+
+	// Whether emergency calling is active on this phone number.
+	EmergencyStatus string `json:"emergency_status"`
+
+	// Whether this phone number is new to the Twilio platform.
+	Beta bool `json:"beta"`
+
+	// The version of the Twilio API used to handle this phone number.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this phone number was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this phone number was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// An AvailablePhoneNumber instance resource represents a phone number that is
+// available to be bought by the account.
+type AvailablePhoneNumber struct {
+	// A nicely formatted version of the phone number.
+	FriendlyName string `json:"friendly_name"`
+
+	// The phone number, in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+
+	// The LATA of this phone number. Only available for US and Canadian
+	// numbers.
+	Lata string `json:"lata"`
+
+	// The locality or city of this phone number.
+	Locality string `json:"locality"`
+
+	// The rate center of this phone number. Only available for US and
+	// Canadian numbers.
+	RateCenter string `json:"rate_center"`
+
+	// The latitude of this phone number.
+	Latitude string `json:"latitude"`
+
+	// The longitude of this phone number.
+	Longitude string `json:"longitude"`
+
+	// The two-letter state or province abbreviation of this phone number.
+	Region string `json:"region"`
+
+	// The postal code of this phone number.
+	PostalCode string `json:"postal_code"`
+
+	// The ISO country code of this phone number.
+	IsoCountry string `json:"iso_country"`
+
+	// The address requirement of this phone number. Either none, any, local,
+	// or foreign.
+	AddressRequirements string `json:"address_requirements"`
+
+	// Whether this phone number is new to the Twilio platform.
+	Beta bool `json:"beta"`
+
+	// The types of communication this phone number supports.
+	Capabilities PhoneNumberCapabilities `json:"capabilities"`
+}
+
+// A Media instance resource represents a media file attached to an MMS
+// message.
+type Media struct {
+	// A 34 character string that uniquely identifies this media.
+	SID string `json:"sid"`
+
+	// The unique id of the Account responsible for this media.
+	AccountSID string `json:"account_sid"`
+
+	// The unique id of the message this media is attached to.
+	ParentSID string `json:"parent_sid"`
+
+	// The MIME type of the media (e.g., image/jpeg).
+	ContentType string `json:"content_type"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this media was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this media was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Recording instance resource represents the audio recording of a call or
+// conference.
+type Recording struct {
+	// A 34 character string that uniquely identifies this recording.
+	SID string `json:"sid"`
+
+	// The unique id of the Account responsible for this recording.
+	AccountSID string `json:"account_sid"`
+
+	// The unique id of the call this recording was made during.
+	CallSID string `json:"call_sid"`
+
+	// The unique id of the conference this recording was made during, if
+	// any.
+	ConferenceSID string `json:"conference_sid"`
+
+	// The status of this recording. Either in-progress, paused, stopped,
+	// processing, completed, or absent.
+	Status string `json:"status"`
+
+	// The number of channels in the recording. Either 1 or 2.
+	Channels int `json:"channels"`
+
+	// How the recording was created (e.g., RecordVerb or OutboundAPI).
+	Source string `json:"source"`
+
+	// The length of the recording in seconds.
+	Duration string `json:"duration"`
+
+	// The charge for this recording, in the currency given by PriceUnit.
+	Price string `json:"price"`
+
+	// The currency in which Price is measured, in ISO 4217 format (e.g. USD).
+	PriceUnit string `json:"price_unit"`
+
+	// The error code, if any, explaining why the recording is absent.
+	ErrorCode int `json:"error_code"`
+
+	// The version of the Twilio API used to make the recording.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The list of subresources under this recording.
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+
+	// The time the recording started.
+	StartTime Time `json:"start_time"`
+
+	// The date that this recording was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this recording was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Transcription instance resource represents the text transcription of a
+// recording.
+type Transcription struct {
+	// A 34 character string that uniquely identifies this transcription.
+	SID string `json:"sid"`
+
+	// The unique id of the Account responsible for this transcription.
+	AccountSID string `json:"account_sid"`
+
+	// The unique id of the recording this transcription is of.
+	RecordingSID string `json:"recording_sid"`
+
+	// The status of this transcription. Either in-progress, completed, or
+	// failed.
+	Status string `json:"status"`
+
+	// The text of the transcription.
+	TranscriptionText string `json:"transcription_text"`
+
+	// The length of the transcribed recording in seconds.
+	Duration string `json:"duration"`
+
+	// The charge for this transcription, in the currency given by PriceUnit.
+	Price string `json:"price"`
+
+	// The currency in which Price is measured, in ISO 4217 format (e.g. USD).
+	PriceUnit string `json:"price_unit"`
+
+	// The type of the transcription (e.g., fast).
+	Type string `json:"type"`
+
+	// The version of the Twilio API used to make the transcription.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this transcription was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this transcription was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Conference instance resource represents a conference call, which connects
+// any number of participants.
+type Conference struct {
+	// A 34 character string that uniquely identifies this conference.
+	SID string `json:"sid"`
+
+	// The unique id of the Account responsible for this conference.
+	AccountSID string `json:"account_sid"`
+
+	// The name given to the conference when it was created.
+	FriendlyName string `json:"friendly_name"`
+
+	// The status of this conference. Either init, in-progress, or completed.
+	Status ConferenceStatus `json:"status"`
+
+	// The region the conference is mixed in (e.g., us1).
+	Region string `json:"region"`
+
+	// Why the conference ended, once it is completed.
+	ReasonConferenceEnded string `json:"reason_conference_ended"`
+
+	// The SID of the participant call that ended the conference, if any.
+	CallSIDEndingConference string `json:"call_sid_ending_conference"`
+
+	// The version of the Twilio API used to handle the conference.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The list of subresources under this conference.
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+
+	// The date that this conference was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this conference was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Participant instance resource represents a call connected to a
+// conference. Participants are identified by the SID of their call.
+type Participant struct {
+	// The unique id of the call connected to the conference.
+	CallSID string `json:"call_sid"`
+
+	// The unique id of the conference the participant is in.
+	ConferenceSID string `json:"conference_sid"`
+
+	// The unique id of the Account responsible for this participant.
+	AccountSID string `json:"account_sid"`
+
+	// A label for the participant, which can be used in place of its CallSID.
+	Label string `json:"label"`
+
+	// The status of this participant. Either queued, connecting, ringing,
+	// connected, complete, or failed.
+	Status ParticipantStatus `json:"status"`
+
+	// Whether the participant is muted.
+	Muted bool `json:"muted"`
+
+	// Whether the participant is on hold.
+	Hold bool `json:"hold"`
+
+	// Whether the participant is coaching another call, meaning only that
+	// call can hear them.
+	Coaching bool `json:"coaching"`
+
+	// The SID of the participant being coached, if Coaching is true.
+	CallSIDToCoach string `json:"call_sid_to_coach"`
+
+	// Whether the conference starts when this participant joins.
+	StartConferenceOnEnter bool `json:"start_conference_on_enter"`
+
+	// Whether the conference ends when this participant leaves.
+	EndConferenceOnExit bool `json:"end_conference_on_exit"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this participant was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this participant was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A Queue instance resource represents a queue of calls waiting to be
+// connected.
+type Queue struct {
+	// A 34 character string that uniquely identifies this queue.
+	SID string `json:"sid"`
+
+	// The unique id of the Account that owns this queue.
+	AccountSID string `json:"account_sid"`
+
+	// A human readable description of the queue.
+	FriendlyName string `json:"friendly_name"`
+
+	// The number of calls currently in the queue.
+	CurrentSize int `json:"current_size"`
+
+	// The maximum number of calls allowed in the queue.
+	MaxSize int `json:"max_size"`
+
+	// The average wait time, in seconds, of the calls in the queue.
+	AverageWaitTime int `json:"average_wait_time"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this queue was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this queue was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A QueueMember instance resource represents a call waiting in a queue.
+// Members are identified by the SID of their call.
+type QueueMember struct {
+	// The unique id of the call waiting in the queue.
+	CallSID string `json:"call_sid"`
+
+	// The unique id of the queue the call is waiting in.
+	QueueSID string `json:"queue_sid"`
+
+	// The call's position in the queue, starting at 1.
+	Position int `json:"position"`
+
+	// The number of seconds the call has been waiting in the queue.
+	WaitTime int `json:"wait_time"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that the call was added to the queue.
+	DateEnqueued Time `json:"date_enqueued"`
+}
+
+// A UsageRecord instance resource represents the usage of a single category of
+// Twilio service over a period of time.
+type UsageRecord struct {
+	// The unique id of the Account that accrued this usage.
+	AccountSID string `json:"account_sid"`
+
+	// The category of usage (e.g., sms, calls, totalprice).
+	Category string `json:"category"`
+
+	// A human readable description of the usage category.
+	Description string `json:"description"`
+
+	// The first day of the period covered by this record, in YYYY-MM-DD format.
+	StartDate string `json:"start_date"`
+
+	// The last day of the period covered by this record, in YYYY-MM-DD format.
+	EndDate string `json:"end_date"`
+
+	// The number of usage events (e.g., the number of messages sent).
+	Count Float `json:"count"`
+
+	// The unit in which Count is measured.
+	CountUnit string `json:"count_unit"`
+
+	// The amount of usage (e.g., the number of minutes for calls).
+	Usage Float `json:"usage"`
+
+	// The unit in which Usage is measured.
+	UsageUnit string `json:"usage_unit"`
+
+	// The total price of the usage, in the currency given by PriceUnit.
+	Price Float `json:"price"`
+
+	// The currency in which Price is measured, in ISO 4217 format (e.g. USD).
+	PriceUnit string `json:"price_unit"`
+
+	// The time the usage was last updated, in ISO 8601 format. Usage is
+	// updated roughly every few minutes, but may lag behind.
+	AsOf string `json:"as_of"`
+
+	// The version of the Twilio API used to calculate this record.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The list of subresources under this record.
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+}
+
+// A UsageTrigger instance resource represents a webhook Twilio calls once the
+// usage of a category crosses a threshold.
+type UsageTrigger struct {
+	// A 34 character string that uniquely identifies this trigger.
+	SID string `json:"sid"`
+
+	// The unique id of the Account that owns this trigger.
+	AccountSID string `json:"account_sid"`
+
+	// A human readable description of the trigger.
+	FriendlyName string `json:"friendly_name"`
+
+	// The usage category the trigger watches.
+	UsageCategory string `json:"usage_category"`
+
+	// The field of the usage record compared against TriggerValue. Either
+	// count, usage, or price.
+	TriggerBy UsageTriggerBy `json:"trigger_by"`
+
+	// How often the trigger resets. Either daily, monthly, yearly, alltime, or
+	// empty for a trigger that fires once.
+	Recurring UsageRecurring `json:"recurring"`
+
+	// The value at which the trigger fires.
+	TriggerValue Float `json:"trigger_value"`
+
+	// The current value of the watched field.
+	CurrentValue Float `json:"current_value"`
+
+	// The URL Twilio requests when the trigger fires.
+	CallbackURL string `json:"callback_url"`
+
+	// The HTTP method Twilio uses to request CallbackURL.
+	CallbackMethod string `json:"callback_method"`
+
+	// The URI of the usage record the trigger watches.
+	UsageRecordURI string `json:"usage_record_uri"`
+
+	// The version of the Twilio API used to handle the trigger.
+	APIVersion string `json:"api_version"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that the trigger last fired.
+	DateFired Time `json:"date_fired"`
+
+	// The date that this trigger was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this trigger was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// An OutgoingCallerID instance resource represents a phone number that has been
+// verified for use as the caller ID of outgoing calls and messages.
+type OutgoingCallerID struct {
+	// A 34 character string that uniquely identifies this caller ID.
+	SID string `json:"sid"`
+
+	// The unique id of the Account that owns this caller ID.
+	AccountSID string `json:"account_sid"`
+
+	// A human readable description of the caller ID.
+	FriendlyName string `json:"friendly_name"`
+
+	// The verified phone number, in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+
+	// The URI for this resource, relative to https://api.twilio.com.
+	URI string `json:"uri"`
+
+	// The date that this caller ID was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this caller ID was last updated.
+	DateUpdated Time `json:"date_updated"`
+}
+
+// A ValidationRequest is the result of starting the verification of a caller
+// ID. Twilio calls the phone number, and the person answering must enter the
+// ValidationCode to complete the verification.
+type ValidationRequest struct {
+	// The unique id of the Account the caller ID is being added to.
+	AccountSID string `json:"account_sid"`
+
+	// The unique id of the verification call.
+	CallSID string `json:"call_sid"`
+
+	// A human readable description of the caller ID.
+	FriendlyName string `json:"friendly_name"`
+
+	// The phone number being verified, in E.164 format.
+	PhoneNumber string `json:"phone_number"`
+
+	// The code that must be entered during the verification call.
+	ValidationCode string `json:"validation_code"`
+}
+
+// A Key instance resource represents an API key, which may be used in place of
+// the account's master credentials to authenticate with the Twilio API.
+type Key struct {
+	// A 34 character string that uniquely identifies this key.
+	SID string `json:"sid"`
+
+	// A human readable description of the key.
+	FriendlyName string `json:"friendly_name"`
+
+	// The secret of the key. This is only returned when the key is created,
+	// and can not be retrieved later.
+	Secret string `json:"secret"`
+
+	// The date that this key was created.
+	DateCreated Time `json:"date_created"`
+
+	// The date that this key was last updated.
+	DateUpdated Time `json:"date_updated"`
 }
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures the automatic retrying of failed requests, using an
+// exponential backoff between attempts.
+//
+// GET and DELETE requests are retried on connection errors, 429 Too Many
+// Requests, and 5xx responses. POST requests are not idempotent, so they are
+// only retried when it's known Twilio did not act on them: when the
+// connection could not be established, or when the request was rejected with
+// a 429.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles with each
+	// subsequent retry.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized to avoid many clients retrying in lockstep. A value of 0.5
+	// means each delay is somewhere between 50% and 100% of its full length.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with reasonable defaults: up to four
+// attempts, starting with a half second delay and capped at ten seconds.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.5,
+	}
+}
+
+// retryable returns whether a request should be attempted again, given the
+// result of the attempt number provided. resp and err are the values returned
+// from the attempt, with err being an *Exception for non-2xx responses.
+func (p *RetryPolicy) retryable(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	// the caller gave up, so there's no point in trying again
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	// the request could not be re-sent
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if resp == nil {
+		if req.Method == "POST" {
+			return isDialError(err)
+		}
+
+		return true
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return req.Method != "POST" && resp.StatusCode >= 500
+}
+
+// delay returns how long to wait before the attempt after the one provided. If
+// the response included a Retry-After header, it is used instead of the
+// backoff.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return d
+	}
+
+	// limit the shift so the delay can't overflow
+	shift := attempt - 1
+
+	if shift > 30 {
+		shift = 30
+	}
+
+	d := p.BaseDelay << uint(shift)
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+
+	return d
+}
+
+// retryAfter parses the Retry-After header of the response, which may either
+// be a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	h := resp.Header.Get("Retry-After")
+
+	if len(h) == 0 {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(h); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(h); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// isDialError returns whether err came from failing to establish a connection,
+// meaning no part of the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// send sends the request using the client's HTTPClient, retrying it according
+// to the client's RetryPolicy. Each attempt first waits on the client's
+// Limiter, if it has one. If the final response status is not 2xx, the body
+// is closed and an *Exception is returned instead.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	var sender string
+
+	if c.Limiter != nil {
+		sender = requestSender(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(req.Context(), c.accountSID(), sender); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+
+		if err == nil {
+			if err = checkResponse(resp); err == nil {
+				return resp, nil
+			}
+
+			resp.Body.Close()
+		}
+
+		if !c.RetryPolicy.retryable(req, resp, err, attempt) {
+			return nil, err
+		}
+
+		if err = sleep(req.Context(), c.RetryPolicy.delay(resp, attempt)); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewind returns a copy of req with a fresh body, so it can be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = body
+
+	return r, nil
+}
+
+// sleep waits for d to elapse, returning early with an error if ctx is done
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingClient wraps an HTTPClientInterface, counting the requests sent
+// through it.
+type countingClient struct {
+	HTTPClientInterface
+	n int32
+}
+
+func (c *countingClient) Do(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&c.n, 1)
+	return c.HTTPClientInterface.Do(r)
+}
+
+func TestClient_send_retries(t *testing.T) {
+	ctx := context.Background()
+
+	var n int32
+
+	client, done := setUpTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// fail the first two attempts at each path
+		if atomic.AddInt32(&n, 1)%3 != 0 {
+			switch r.URL.Path {
+			case "/x/Limited.json":
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+			default:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+
+			return
+		}
+
+		r.ParseForm()
+		fmt.Fprintf(w, `{"body": %q}`, r.PostForm.Get("Body"))
+	})
+
+	defer done()
+
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	tests := []struct {
+		method, resource string
+		ok               bool
+		desc             string
+	}{
+		{method: "GET", resource: "/Broken", ok: true, desc: `GETs should be retried on 5xx`},
+		{method: "POST", resource: "/Limited", ok: true, desc: `POSTs should be retried on 429`},
+		{method: "POST", resource: "/Broken", ok: false, desc: `POSTs should not be retried on 5xx`},
+	}
+
+	for _, tt := range tests {
+		atomic.StoreInt32(&n, 0)
+
+		form := url.Values{}
+		form.Set("Body", "hi")
+
+		req, err := newRequest(ctx, client, tt.method, tt.resource, form)
+
+		if err != nil {
+			t.Fatalf("newRequest() = _, %s; want <nil>", err)
+		}
+
+		var out struct {
+			Body string `json:"body"`
+		}
+
+		err = client.do(req, &out)
+
+		if tt.ok && err != nil {
+			t.Errorf("\nDescription: %s\nclient.do() = %s; want <nil>", tt.desc, err)
+			continue
+		}
+
+		if !tt.ok && err == nil {
+			t.Errorf("\nDescription: %s\nclient.do() = <nil>; want error", tt.desc)
+			continue
+		}
+
+		if tt.ok && tt.method == "POST" && out.Body != "hi" {
+			t.Errorf("\nDescription: %s\nout.Body = %q; want %q", tt.desc, out.Body, "hi")
+		}
+	}
+
+	// without a policy there should be no retries
+	client.RetryPolicy = nil
+	atomic.StoreInt32(&n, 0)
+
+	if _, err := client.get(ctx, "/Broken", nil); err == nil {
+		t.Error("client.get() without RetryPolicy = _, <nil>; want error")
+	}
+
+	if got := atomic.LoadInt32(&n); got != 1 {
+		t.Errorf("requests without RetryPolicy = %d; want 1", got)
+	}
+}
+
+func TestClient_send_dialError(t *testing.T) {
+	// grab a free port, and close it so connections to it are refused
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("net.Listen() = _, %s; want <nil>", err)
+	}
+
+	addr := l.Addr().String()
+	l.Close()
+
+	client := testClient(addr)
+	cc := &countingClient{HTTPClientInterface: client.HTTPClient}
+	client.HTTPClient = cc
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	if err = client.post(context.Background(), "/Messages", url.Values{"Body": {"x"}}, nil); err == nil {
+		t.Fatal("client.post() = <nil>; want error")
+	}
+
+	if n := atomic.LoadInt32(&cc.n); n != 3 {
+		t.Errorf("attempts = %d; want 3", n)
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		header  string
+		out     time.Duration
+	}{
+		{attempt: 1, out: time.Second},
+		{attempt: 2, out: 2 * time.Second},
+		{attempt: 3, out: 4 * time.Second},
+		{attempt: 4, out: 5 * time.Second},
+		{attempt: 100, out: 5 * time.Second},
+		{attempt: 1, header: "7", out: 7 * time.Second},
+		{attempt: 1, header: "Thu, 01 Jan 1970 00:00:00 GMT", out: 0},
+		{attempt: 2, header: "garbage", out: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+
+		if len(tt.header) > 0 {
+			resp.Header.Set("Retry-After", tt.header)
+		}
+
+		if d := p.delay(resp, tt.attempt); d != tt.out {
+			t.Errorf("p.delay(%q, %d) = %s; want %s", tt.header, tt.attempt, d, tt.out)
+		}
+	}
+
+	p.Jitter = 0.5
+
+	for i := 0; i < 100; i++ {
+		if d := p.delay(nil, 1); d < 500*time.Millisecond || d > time.Second {
+			t.Fatalf("p.delay(nil, 1) with Jitter 0.5 = %s; want [500ms, 1s]", d)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+)
+
+// TranscriptionsService provides access to the Transcriptions resource of the
+// Twilio API, either for the whole account or for a single recording.
+type TranscriptionsService struct {
+	client *Client
+
+	// prefix is the resource the transcriptions are nested under, and is
+	// empty for the account level.
+	prefix string
+}
+
+// Transcriptions returns an iterator over the transcriptions of all the
+// recordings of the call with the given SID. Twilio only nests transcriptions
+// under recordings, so they are found by walking the recordings of the call,
+// and the limits in opts apply to the transcriptions across all of them.
+// Individual transcriptions can be fetched or deleted using the account level
+// TranscriptionsService.
+func (s *CallsService) Transcriptions(callSID string, opts ListOptions) *Iterator[Transcription] {
+	recs := s.Recordings(callSID).List(&RecordingListParams{ListOptions: ListOptions{PageSize: opts.PageSize}})
+
+	return newChainIterator[Transcription](s.client, "transcriptions", opts, func(ctx context.Context) (string, error) {
+		if !recs.Next(ctx) {
+			return "", recs.Err()
+		}
+
+		return s.client.Recordings.Transcriptions(recs.Value().SID).resource("")
+	})
+}
+
+func (s *TranscriptionsService) resource(sid string) (string, error) {
+	if s.prefix == "/Recordings/" {
+		return "", errors.New("recording sid cannot be zero length")
+	}
+
+	if len(sid) == 0 {
+		return s.prefix + "/Transcriptions", nil
+	}
+
+	return s.prefix + resourcePath("Transcriptions", sid), nil
+}
+
+// List returns an iterator over the transcriptions.
+func (s *TranscriptionsService) List(opts ListOptions) *Iterator[Transcription] {
+	resource, err := s.resource("")
+
+	if err != nil {
+		return errIterator[Transcription](err)
+	}
+
+	return newIterator[Transcription](s.client, resource, "transcriptions", nil, opts)
+}
+
+// Get fetches the transcription with the given SID.
+func (s *TranscriptionsService) Get(ctx context.Context, sid string) (*Transcription, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	resource, err := s.resource(sid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Transcription{}
+
+	if err = s.client.getJSON(ctx, resource, nil, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Delete removes the transcription with the given SID.
+func (s *TranscriptionsService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	resource, err := s.resource(sid)
+
+	if err != nil {
+		return err
+	}
+
+	return s.client.delete(ctx, resource)
+}
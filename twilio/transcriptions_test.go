@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestTranscriptionsService_List(t *testing.T) {
+	testService(t, []serviceTest[[]Transcription]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Transcriptions.List(ListOptions{MaxItems: 2}))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Transcriptions.json", body: `{"transcriptions": [{"sid": "TR1"}, {"sid": "TR2"}, {"sid": "TR3"}], "next_page_uri": null}`},
+			},
+			out:  []Transcription{{SID: "TR1"}, {SID: "TR2"}},
+			desc: `the transcriptions of the account should be listed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Recordings.Transcriptions("RE1").List(ListOptions{}))
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Recordings/RE1/Transcriptions.json", body: `{"transcriptions": [{"sid": "TR1", "recording_sid": "RE1"}], "next_page_uri": null}`},
+			},
+			out:  []Transcription{{SID: "TR1", RecordingSID: "RE1"}},
+			desc: `the transcriptions of a recording should be listed from within the recording`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Recordings.Transcriptions("").List(ListOptions{}))
+			},
+			err:  true,
+			desc: `an empty recording sid should be rejected`,
+		},
+	})
+}
+
+func TestTranscriptionsService_Get(t *testing.T) {
+	testService(t, []serviceTest[*Transcription]{
+		{
+			call: func(ctx context.Context, c *Client) (*Transcription, error) {
+				return c.Transcriptions.Get(ctx, "TR1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Transcriptions/TR1.json", body: `{"sid": "TR1", "status": "completed", "transcription_text": "acknowledged, looking now"}`},
+			},
+			out:  &Transcription{SID: "TR1", Status: "completed", TranscriptionText: "acknowledged, looking now"},
+			desc: `the transcription should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Transcription, error) {
+				return c.Recordings.Transcriptions("").Get(ctx, "TR1")
+			},
+			err:  true,
+			desc: `an empty recording sid should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*Transcription, error) {
+				return c.Transcriptions.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestTranscriptionsService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Transcriptions.Delete(ctx, "TR1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Transcriptions/TR1.json", status: http.StatusNoContent},
+			},
+			desc: `the transcription should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Transcriptions.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestCallsService_Transcriptions(t *testing.T) {
+	recordings := exchange{method: "GET", path: "/x/Calls/CA1/Recordings.json", body: `{"recordings": [{"sid": "RE1"}, {"sid": "RE2"}], "next_page_uri": null}`}
+	re1 := exchange{method: "GET", path: "/x/Recordings/RE1/Transcriptions.json", body: `{"transcriptions": [{"sid": "TR1", "recording_sid": "RE1"}], "next_page_uri": null}`}
+	re2 := exchange{method: "GET", path: "/x/Recordings/RE2/Transcriptions.json", body: `{"transcriptions": [{"sid": "TR2", "recording_sid": "RE2"}], "next_page_uri": null}`}
+
+	testService(t, []serviceTest[[]Transcription]{
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Calls.Transcriptions("CA1", ListOptions{}))
+			},
+			exchanges: []exchange{recordings, re1, re2},
+			out:       []Transcription{{SID: "TR1", RecordingSID: "RE1"}, {SID: "TR2", RecordingSID: "RE2"}},
+			desc:      `the transcriptions of each recording of the call should be listed`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Calls.Transcriptions("CA1", ListOptions{MaxItems: 1}))
+			},
+			exchanges: []exchange{recordings, re1},
+			out:       []Transcription{{SID: "TR1", RecordingSID: "RE1"}},
+			desc:      `MaxItems should stop the listing before the next recording is fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) ([]Transcription, error) {
+				return collect(ctx, c.Calls.Transcriptions("", ListOptions{}))
+			},
+			err:  true,
+			desc: `an empty call sid should be rejected`,
+		},
+	})
+}
@@ -7,12 +7,19 @@
 package twilio
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/theckman/houston/twilio/util"
 )
@@ -31,17 +38,99 @@ var userAgent = fmt.Sprintf(
 )
 
 // Client is the struct representing a Twilio client.
+//
+// SID and Secret are the credentials used to authenticate, which are either
+// an Account SID and Auth Token or an API Key SID and secret. AccountSID is
+// the account whose resources are used. When using an API key, AccountSID
+// must be set.
+//
+// A Client created as a struct literal, rather than with New or NewWithAPIKey,
+// must have Init called on it before its services are used.
+//
+// SID and Secret may be read and written directly while the client is not in
+// use. Once it is shared between goroutines, use Credentials and
+// SetCredentials instead.
 type Client struct {
 	SID        string
 	Secret     string
 	HTTPClient HTTPClientInterface
 	BaseURL    string
+
+	// AccountSID is the SID of the account whose resources are used. If
+	// empty, SID is used, which only works with the account's master
+	// credentials.
+	AccountSID string
+
+	// mu protects SID and Secret, so that the credentials can be swapped
+	// while requests are in flight.
+	mu sync.RWMutex
+
+	// parent is the client whose credentials are used, for a client created
+	// by Subaccount. This lets a key rotation of the parent apply to all of
+	// its subaccount clients.
+	parent *Client
+
+	// RetryPolicy controls whether failed requests are retried. It is nil by
+	// default, meaning requests are never retried.
+	RetryPolicy *RetryPolicy
+
+	// Limiter is used to queue requests so they stay under the Twilio rate
+	// limits. It is nil by default, meaning requests are never delayed.
+	Limiter Limiter
+
+	// Messages is used to send and manage SMS and MMS messages.
+	Messages *MessagesService
+
+	// Calls is used to place and manage voice calls.
+	Calls *CallsService
+
+	// Accounts is used to manage the account and its subaccounts.
+	Accounts *AccountsService
+
+	// Addresses is used to manage the addresses registered with the account.
+	Addresses *AddressesService
+
+	// IncomingPhoneNumbers is used to manage the phone numbers owned by the
+	// account.
+	IncomingPhoneNumbers *IncomingPhoneNumbersService
+
+	// AvailablePhoneNumbers is used to search for phone numbers to buy.
+	AvailablePhoneNumbers *AvailablePhoneNumbersService
+
+	// Recordings is used to manage the call recordings of the account.
+	Recordings *RecordingsService
+
+	// Transcriptions is used to manage the transcriptions of the account's
+	// recordings.
+	Transcriptions *TranscriptionsService
+
+	// Conferences is used to manage conference calls and their participants.
+	Conferences *ConferencesService
+
+	// Queues is used to manage call queues and the calls waiting in them.
+	Queues *QueuesService
+
+	// Usage is used to report on the account's usage and to manage usage
+	// triggers.
+	Usage *UsageService
+
+	// OutgoingCallerIDs is used to manage the account's verified caller IDs.
+	OutgoingCallerIDs *OutgoingCallerIDsService
+
+	// ValidationRequests is used to verify new caller IDs.
+	ValidationRequests *ValidationRequestsService
+
+	// Keys is used to manage the account's standard API keys.
+	Keys *KeysService
+
+	// SigningKeys is used to manage the account's signing keys.
+	SigningKeys *KeysService
 }
 
-// New is a function that takes a sid and secret and returns a *Client. The sid
-// and secret value depend on whether you are using your account's Master Keys
-// (AccountSid and AuthToken) or generated API keys (API Key SID, API Key
-// Secret).
+// New is a function that takes your account's Master Keys (AccountSid and
+// AuthToken) and returns a *Client. To authenticate with generated API keys
+// (API Key SID, API Key Secret), use NewWithAPIKey instead; New returns an
+// error if sid is an API Key SID.
 func New(sid, secret string) (*Client, error) {
 	if len(sid) == 0 {
 		return nil, errors.New("sid cannot be zero length")
@@ -51,12 +140,176 @@ func New(sid, secret string) (*Client, error) {
 		return nil, errors.New("secret cannot be zero length")
 	}
 
-	return &Client{
+	if strings.HasPrefix(sid, "SK") {
+		return nil, errors.New("sid is an API key SID; use NewWithAPIKey")
+	}
+
+	c := &Client{
 		SID:        sid,
 		Secret:     secret,
+		AccountSID: sid,
+		HTTPClient: util.DefaultPooledClient(),
+		BaseURL:    TwilioAPIBase,
+	}
+
+	c.Init()
+
+	return c, nil
+}
+
+// NewWithAPIKey is a function that takes the SID of an account, and the SID
+// and secret of one of its API keys, and returns a *Client. The client
+// authenticates with the API key, but uses the resources of the account.
+func NewWithAPIKey(accountSID, keySID, keySecret string) (*Client, error) {
+	if len(accountSID) == 0 {
+		return nil, errors.New("accountSID cannot be zero length")
+	}
+
+	if len(keySID) == 0 {
+		return nil, errors.New("keySID cannot be zero length")
+	}
+
+	if len(keySecret) == 0 {
+		return nil, errors.New("keySecret cannot be zero length")
+	}
+
+	c := &Client{
+		SID:        keySID,
+		Secret:     keySecret,
+		AccountSID: accountSID,
 		HTTPClient: util.DefaultPooledClient(),
 		BaseURL:    TwilioAPIBase,
-	}, nil
+	}
+
+	c.Init()
+
+	return c, nil
+}
+
+// Init sets up the resource services of the client, such as Messages and
+// Calls. New and NewWithAPIKey call it for you; a *Client built as a struct
+// literal must call Init before any of its services are used.
+func (c *Client) Init() {
+	c.Messages = &MessagesService{client: c}
+	c.Calls = &CallsService{client: c}
+	c.Accounts = &AccountsService{client: c}
+	c.Addresses = &AddressesService{client: c}
+	c.IncomingPhoneNumbers = &IncomingPhoneNumbersService{client: c}
+	c.AvailablePhoneNumbers = &AvailablePhoneNumbersService{client: c}
+	c.Recordings = &RecordingsService{client: c}
+	c.Transcriptions = &TranscriptionsService{client: c}
+	c.Conferences = &ConferencesService{client: c}
+	c.Queues = &QueuesService{client: c}
+	c.Usage = &UsageService{
+		Records:  &UsageRecordsService{client: c},
+		Triggers: &UsageTriggersService{client: c},
+	}
+	c.OutgoingCallerIDs = &OutgoingCallerIDsService{client: c}
+	c.ValidationRequests = &ValidationRequestsService{client: c}
+	c.Keys = &KeysService{client: c, resource: "/Keys", key: "keys"}
+	c.SigningKeys = &KeysService{client: c, resource: "/SigningKeys", key: "signing_keys"}
+}
+
+// Credentials returns the SID and secret the client authenticates with. It is
+// safe to call concurrently with SetCredentials.
+func (c *Client) Credentials() (sid, secret string) {
+	if c.parent != nil {
+		return c.parent.Credentials()
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.SID, c.Secret
+}
+
+// SetCredentials atomically replaces the SID and secret the client
+// authenticates with. Requests built after it returns use the new
+// credentials. For a client created by Subaccount, the credentials of the
+// parent client are replaced.
+func (c *Client) SetCredentials(sid, secret string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	if len(secret) == 0 {
+		return errors.New("secret cannot be zero length")
+	}
+
+	if c.parent != nil {
+		return c.parent.SetCredentials(sid, secret)
+	}
+
+	c.mu.Lock()
+	c.SID, c.Secret = sid, secret
+	c.mu.Unlock()
+
+	return nil
+}
+
+// swapCredentials replaces the credentials of the client with sid and secret,
+// but only if it currently authenticates as oldSID. It reports whether the
+// credentials were replaced.
+func (c *Client) swapCredentials(oldSID, sid, secret string) bool {
+	if c.parent != nil {
+		return c.parent.swapCredentials(oldSID, sid, secret)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.SID != oldSID {
+		return false
+	}
+
+	c.SID, c.Secret = sid, secret
+
+	return true
+}
+
+// accountSID returns the SID of the account whose resources the client uses.
+func (c *Client) accountSID() string {
+	if len(c.AccountSID) > 0 {
+		return c.AccountSID
+	}
+
+	sid, _ := c.Credentials()
+
+	return sid
+}
+
+// Subaccount returns a new *Client for the resources of the subaccount with
+// the given SID, which authenticates using the credentials of c. The new
+// client shares the HTTPClient, RetryPolicy, and Limiter of c, and always
+// uses the current credentials of c, even if they are later changed with
+// SetCredentials or RotateKey.
+func (c *Client) Subaccount(sid string) (*Client, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	parent := c
+
+	if c.parent != nil {
+		parent = c.parent
+	}
+
+	csid, secret := parent.Credentials()
+
+	sc := &Client{
+		SID:         csid,
+		Secret:      secret,
+		parent:      parent,
+		HTTPClient:  c.HTTPClient,
+		BaseURL:     c.BaseURL,
+		RetryPolicy: c.RetryPolicy,
+		Limiter:     c.Limiter,
+		AccountSID:  sid,
+	}
+
+	sc.Init()
+
+	return sc, nil
 }
 
 // format takes a resource and ensures it meets the format we expect
@@ -82,46 +335,110 @@ func formatValues(values url.Values) string {
 	return "?" + values.Encode()
 }
 
-func newRequest(client *Client, method, resource string, values url.Values) (*http.Request, error) {
+// setString sets key to value in v, unless value is empty.
+func setString(v url.Values, key, value string) {
+	if len(value) > 0 {
+		v.Set(key, value)
+	}
+}
+
+// setInt sets key to value in v, unless value is not a positive number.
+func setInt(v url.Values, key string, value int) {
+	if value > 0 {
+		v.Set(key, strconv.Itoa(value))
+	}
+}
+
+// setBool sets key to the value pointed to by value in v, unless value is nil.
+func setBool(v url.Values, key string, value *bool) {
+	if value != nil {
+		v.Set(key, strconv.FormatBool(*value))
+	}
+}
+
+// setDate sets key to the YYYY-MM-DD representation of value in v, unless
+// value is the zero time. This is the format Twilio expects for date filters.
+func setDate(v url.Values, key string, value time.Time) {
+	if !value.IsZero() {
+		v.Set(key, value.Format("2006-01-02"))
+	}
+}
+
+// resourcePath joins segments in to a resource path (e.g., "/Calls/CA123"),
+// escaping each of them so that values such as friendly names can not change
+// the structure of the path.
+func resourcePath(segments ...string) string {
+	var b strings.Builder
+
+	for _, seg := range segments {
+		b.WriteByte('/')
+		b.WriteString(url.PathEscape(seg))
+	}
+
+	return b.String()
+}
+
+// resourceURL returns the absolute URL of the JSON representation of a
+// resource within the client's account.
+func (c *Client) resourceURL(resource string) string {
+	return c.formatURL(resource, "json")
+}
+
+// formatURL returns the absolute URL of a resource within the client's
+// account, in the given format (e.g., "mp3" for a recording).
+func (c *Client) formatURL(resource, format string) string {
+	return fmt.Sprintf(
+		"%s/%s%s.%s",
+		c.BaseURL, url.PathEscape(c.accountSID()),
+		formatResource(resource), format,
+	)
+}
+
+func newRequest(ctx context.Context, client *Client, method, resource string, values url.Values) (*http.Request, error) {
 	if client == nil {
 		return nil, errors.New("*Client cannot be nil")
 	}
 
-	var r *http.Request
-	var err error
+	urlStr := client.resourceURL(resource)
+
+	var body io.Reader
 
 	switch method {
-	case "GET":
-		urlStr := fmt.Sprintf(
-			"%s/%s%s.json%s",
-			client.BaseURL, client.SID,
-			formatResource(resource),
-			formatValues(values),
-		)
+	case "GET", "DELETE":
+		urlStr += formatValues(values)
+	case "POST":
+		body = strings.NewReader(values.Encode())
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method %q", method)
+	}
 
-		r, err = http.NewRequest(method, urlStr, nil)
+	return newURLRequest(ctx, client, method, urlStr, body)
+}
 
-		if err != nil {
-			return nil, err
-		}
+// newURLRequest builds a request for an absolute URL, setting the headers and
+// authentication needed by every request to the Twilio API. If body is
+// non-nil it is assumed to be form-encoded. The request is bound to ctx, so
+// cancelling ctx aborts the request.
+func newURLRequest(ctx context.Context, client *Client, method, urlStr string, body io.Reader) (*http.Request, error) {
+	if client == nil {
+		return nil, errors.New("*Client cannot be nil")
+	}
 
-		//r.URL.RawQuery = values.Encode()
-	case "POST":
-		urlStr := fmt.Sprintf(
-			"%s/%s%s.json",
-			client.BaseURL, client.SID,
-			formatResource(resource),
-		)
+	r, err := http.NewRequestWithContext(ctx, method, urlStr, body)
 
-		r, err = http.NewRequest(method, urlStr, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	if body != nil {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	}
 
+	r.Header.Set("Accept", "application/json")
 	r.Header.Set("User-Agent", userAgent)
-	r.SetBasicAuth(client.SID, client.Secret)
+	sid, secret := client.Credentials()
+
+	r.SetBasicAuth(sid, secret)
 
 	return r, nil
 }
@@ -129,26 +446,87 @@ func newRequest(client *Client, method, resource string, values url.Values) (*ht
 // TestFunc is for development purposes.
 //
 // TODO(heckman): remove this function before any significant release.
-func (c *Client) TestFunc() (*http.Response, error) {
-	return c.get("", nil)
+func (c *Client) TestFunc(ctx context.Context) (*http.Response, error) {
+	return c.get(ctx, "", nil)
 }
 
-func (c *Client) get(resource string, params url.Values) (*http.Response, error) {
-	req, err := newRequest(c, "GET", resource, params)
+// get requests the resource and returns the response. If the response status
+// is not 2xx, an *Exception is returned instead.
+func (c *Client) get(ctx context.Context, resource string, params url.Values) (*http.Response, error) {
+	req, err := newRequest(ctx, c, "GET", resource, params)
 
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.HTTPClient.Do(req)
+	return c.send(req)
+}
+
+// getJSON requests the resource and decodes the JSON response in to v.
+func (c *Client) getJSON(ctx context.Context, resource string, params url.Values, v interface{}) error {
+	req, err := newRequest(ctx, c, "GET", resource, params)
+
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, v)
+}
+
+// post sends formData to the resource as an application/x-www-form-urlencoded
+// body. If v is non-nil the JSON response is decoded in to it.
+func (c *Client) post(ctx context.Context, resource string, formData url.Values, v interface{}) error {
+	req, err := newRequest(ctx, c, "POST", resource, formData)
 
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	return c.do(req, v)
+}
+
+// delete removes the resource. Twilio responds to a successful DELETE with a
+// 204 No Content, so there is nothing to decode.
+func (c *Client) delete(ctx context.Context, resource string) error {
+	req, err := newRequest(ctx, c, "DELETE", resource, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// doAs is like do for a request to the resource, but authenticates with sid and
+// secret instead of the credentials of the client.
+func (c *Client) doAs(ctx context.Context, sid, secret, method, resource string, values url.Values, v interface{}) error {
+	req, err := newRequest(ctx, c, method, resource, values)
+
+	if err != nil {
+		return err
 	}
 
-	return resp, nil
+	req.SetBasicAuth(sid, secret)
+
+	return c.do(req, v)
 }
 
-func (c *Client) post(resource string, formData url.Values) (*http.Response, error) {
-	return nil, nil
+// do sends the request using the client's HTTPClient. If v is non-nil, the
+// JSON response body is decoded in to it. The response body is always closed
+// before returning. Non-2xx responses are returned as an *Exception.
+func (c *Client) do(req *http.Request, v interface{}) error {
+	resp, err := c.send(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if v == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
 }
@@ -7,12 +7,16 @@
 package twilio
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"reflect"
 	"runtime"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,12 +24,16 @@ import (
 )
 
 func testClient(addr string) *Client {
-	return &Client{
+	c := &Client{
 		SID:        "x",
 		Secret:     "y",
 		HTTPClient: util.DefaultClient(),
 		BaseURL:    fmt.Sprintf("http://%s", addr),
 	}
+
+	c.Init()
+
+	return c
 }
 
 func setUpTestHTTPServer(h http.HandlerFunc) (net.Listener, *http.Server, error) {
@@ -52,6 +60,170 @@ func setUpTestHTTPServer(h http.HandlerFunc) (net.Listener, *http.Server, error)
 	return listener, server, nil
 }
 
+// setUpTestClient starts a test HTTP server using h and returns a *Client
+// pointed at it, along with a function to shut the server down.
+func setUpTestClient(t *testing.T, h http.HandlerFunc) (*Client, func()) {
+	l, s, err := setUpTestHTTPServer(h)
+
+	if err != nil {
+		t.Fatalf("setUpTestHTTPServer() = %s; want <nil>", err.Error())
+	}
+
+	return testClient(l.Addr().String()), func() {
+		s.Close()
+		l.Close()
+	}
+}
+
+// exchange is a request a service test expects the client to make, along with
+// the response the test server sends back for it.
+type exchange struct {
+	// method and path are the expected method and escaped URL path of the
+	// request (e.g., "GET", "/x/Calls/CA1.json").
+	method, path string
+
+	// form is the expected query of a GET or DELETE request, or the expected
+	// body of a POST request.
+	form url.Values
+
+	// user, if set, is the expected basic auth user of the request.
+	user string
+
+	// status is the status code of the response. Zero means 200.
+	status int
+
+	// body is the body of the response.
+	body string
+}
+
+// notFound is the body of a 404 response from Twilio.
+const notFound = `{"code": 20404, "message": "The requested resource was not found", "status": 404}`
+
+// serviceTest is a table test of a single service method. The call is made
+// against a test server that expects exactly the requests in exchanges, in
+// order.
+type serviceTest[T any] struct {
+	call      func(ctx context.Context, c *Client) (T, error)
+	exchanges []exchange
+	out       T
+	err       bool
+	desc      string
+}
+
+// received is a request seen by the test server of testService.
+type received struct {
+	method, path, user string
+	form               url.Values
+}
+
+// testService runs each of the tests against its own test server, checking
+// the requests made by the call and the value it returned.
+func testService[T any](t *testing.T, tests []serviceTest[T]) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		var mu sync.Mutex
+		var reqs []received
+
+		client, done := setUpTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+
+			v := r.URL.Query()
+
+			if r.Method == "POST" {
+				v = r.PostForm
+			}
+
+			user, _, _ := r.BasicAuth()
+
+			mu.Lock()
+			i := len(reqs)
+			reqs = append(reqs, received{method: r.Method, path: r.URL.EscapedPath(), user: user, form: v})
+			mu.Unlock()
+
+			if i >= len(tt.exchanges) {
+				w.WriteHeader(http.StatusTeapot)
+				return
+			}
+
+			if tt.exchanges[i].status != 0 {
+				w.WriteHeader(tt.exchanges[i].status)
+			}
+
+			fmt.Fprint(w, tt.exchanges[i].body)
+		})
+
+		out, err := tt.call(ctx, client)
+
+		done()
+
+		mu.Lock()
+
+		if len(reqs) != len(tt.exchanges) {
+			t.Errorf("\nDescription: %s\nlen(requests) = %d; want %d", tt.desc, len(reqs), len(tt.exchanges))
+		}
+
+		for i := 0; i < len(reqs) && i < len(tt.exchanges); i++ {
+			got, want := reqs[i], tt.exchanges[i]
+
+			if got.method != want.method || got.path != want.path {
+				t.Errorf("\nDescription: %s\nrequest %d = %s %s; want %s %s", tt.desc, i, got.method, got.path, want.method, want.path)
+			}
+
+			if len(want.user) > 0 && got.user != want.user {
+				t.Errorf("\nDescription: %s\nrequest %d user = %q; want %q", tt.desc, i, got.user, want.user)
+			}
+
+			if (len(got.form) > 0 || len(want.form) > 0) && !reflect.DeepEqual(got.form, want.form) {
+				t.Errorf("\nDescription: %s\nrequest %d form = %v; want %v", tt.desc, i, got.form, want.form)
+			}
+		}
+
+		mu.Unlock()
+
+		if tt.err {
+			if err == nil {
+				t.Errorf("\nDescription: %s\ncall() = _, <nil>; want error", tt.desc)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("\nDescription: %s\ncall() = _, %s; want <nil>", tt.desc, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(out, tt.out) {
+			t.Errorf("\nDescription: %s\ncall() = %+v; want %+v", tt.desc, out, tt.out)
+		}
+	}
+}
+
+// collect drains the iterator, returning its items.
+func collect[T any](ctx context.Context, it *Iterator[T]) ([]T, error) {
+	var items []T
+
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+
+	return items, it.Err()
+}
+
+// form builds url.Values from key/value pairs.
+func form(kv ...string) url.Values {
+	v := url.Values{}
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		v.Add(kv[i], kv[i+1])
+	}
+
+	return v
+}
+
 func TestNew(t *testing.T) {
 	_, err := New("", "y")
 
@@ -65,10 +237,14 @@ func TestNew(t *testing.T) {
 		t.Errorf("New() failed to validate secret to ensure it is valid")
 	}
 
+	if _, err = New("SK1", "y"); err == nil {
+		t.Error("New() with an API key SID = _, <nil>; want error")
+	}
+
 	client, err := New("x", "y")
 
 	if err != nil || client == nil {
-		t.Errorf("New(\"x\", \"y\") = %q, %q; want *Client, <nil>", client, err)
+		t.Errorf("New(\"x\", \"y\") = %v, %v; want *Client, <nil>", client, err)
 	}
 
 	if client.SID != "x" {
@@ -79,11 +255,94 @@ func TestNew(t *testing.T) {
 		t.Errorf("client.Secret = %q; want %q", client.Secret, "y")
 	}
 
+	if client.AccountSID != "x" {
+		t.Errorf("client.AccountSID = %q; want %q", client.AccountSID, "x")
+	}
+
 	if client.BaseURL != TwilioAPIBase {
 		t.Errorf("client.BaseURL = %q; want %q", client.BaseURL, TwilioAPIBase)
 	}
 }
 
+func TestNewWithAPIKey(t *testing.T) {
+	if _, err := NewWithAPIKey("", "SK1", "y"); err == nil {
+		t.Error("NewWithAPIKey() failed to validate accountSID to ensure it is valid")
+	}
+
+	if _, err := NewWithAPIKey("AC1", "", "y"); err == nil {
+		t.Error("NewWithAPIKey() failed to validate keySID to ensure it is valid")
+	}
+
+	if _, err := NewWithAPIKey("AC1", "SK1", ""); err == nil {
+		t.Error("NewWithAPIKey() failed to validate keySecret to ensure it is valid")
+	}
+
+	client, err := NewWithAPIKey("AC1", "SK1", "y")
+
+	if err != nil || client == nil {
+		t.Fatalf("NewWithAPIKey(\"AC1\", \"SK1\", \"y\") = %v, %v; want *Client, <nil>", client, err)
+	}
+
+	if client.AccountSID != "AC1" || client.SID != "SK1" || client.Secret != "y" {
+		t.Errorf("client = %+v; want AccountSID AC1 authenticating as SK1", client)
+	}
+
+	client.BaseURL = "http://example.org"
+
+	req, err := newRequest(context.Background(), client, "GET", "/Messages", nil)
+
+	if err != nil {
+		t.Fatalf("newRequest() = _, %s; want <nil>", err)
+	}
+
+	if want := "http://example.org/AC1/Messages.json"; req.URL.String() != want {
+		t.Errorf("req.URL = %q; want %q", req.URL.String(), want)
+	}
+
+	if user, _, _ := req.BasicAuth(); user != "SK1" {
+		t.Errorf("basic auth user = %q; want \"SK1\"", user)
+	}
+}
+
+func TestClient_Init(t *testing.T) {
+	l, s, err := setUpTestHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "POST /x/Messages.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		fmt.Fprint(w, `{"sid": "SM1"}`)
+	})
+
+	if err != nil {
+		t.Fatalf("setUpTestHTTPServer() = %s; want <nil>", err)
+	}
+
+	defer func() {
+		s.Close()
+		l.Close()
+	}()
+
+	client := &Client{
+		SID:        "x",
+		Secret:     "y",
+		HTTPClient: util.DefaultClient(),
+		BaseURL:    "http://" + l.Addr().String(),
+	}
+
+	client.Init()
+
+	msg, err := client.Messages.Send(context.Background(), &MessageParams{To: "+15005550006", From: "+15005550001", Body: "hi"})
+
+	if err != nil {
+		t.Fatalf("client.Messages.Send() = _, %s; want <nil>", err)
+	}
+
+	if msg.SID != "SM1" {
+		t.Errorf("msg.SID = %q; want \"SM1\"", msg.SID)
+	}
+}
+
 func Test_formatResource(t *testing.T) {
 	tests := []struct {
 		in, out string
@@ -130,14 +389,16 @@ func Test_formatValues(t *testing.T) {
 }
 
 func Test_newRequest(t *testing.T) {
+	ctx := context.Background()
+
 	client := testClient("127.0.0.1:8080")
 	v := url.Values{}
 	v.Set("testQuery", "set")
 
-	req, err := newRequest(client, "GET", "/q", v)
+	req, err := newRequest(ctx, client, "GET", "/q", v)
 
 	if err != nil {
-		t.Fatalf("newRequest(client, \"GET\", \"/q\", v) = <nil>, %s; want *http.Request, <nil>", err.Error())
+		t.Fatalf("newRequest(ctx, client, \"GET\", \"/q\", v) = <nil>, %s; want *http.Request, <nil>", err.Error())
 	}
 
 	if req.Method != "GET" {
@@ -154,11 +415,41 @@ func Test_newRequest(t *testing.T) {
 	}
 
 	if user, pass, ok := req.BasicAuth(); !ok || (user != "x" && pass != "y") {
-		t.Errorf("req.BasicAuth = %q, %q, %q; want \"x\", \"y\", true ", user, pass, ok)
+		t.Errorf("req.BasicAuth = %q, %q, %t; want \"x\", \"y\", true ", user, pass, ok)
+	}
+
+	req, err = newRequest(ctx, client, "POST", "/q", v)
+
+	if err != nil {
+		t.Fatalf("newRequest(ctx, client, \"POST\", \"/q\", v) = <nil>, %s; want *http.Request, <nil>", err.Error())
+	}
+
+	if ct := req.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+		t.Errorf("req.Header.Get(\"Content-Type\") = %q; want %q", ct, "application/x-www-form-urlencoded")
+	}
+
+	if req.URL.RawQuery != "" {
+		t.Errorf("req.URL.RawQuery = %q; want \"\"", req.URL.RawQuery)
+	}
+
+	req, err = newRequest(ctx, client, "DELETE", "/q", nil)
+
+	if err != nil {
+		t.Fatalf("newRequest(ctx, client, \"DELETE\", \"/q\", nil) = <nil>, %s; want *http.Request, <nil>", err.Error())
+	}
+
+	if req.Method != "DELETE" {
+		t.Errorf("req.Method = %q; want %q", req.Method, "DELETE")
+	}
+
+	if _, err = newRequest(ctx, client, "PATCH", "/q", nil); err == nil {
+		t.Error("newRequest(ctx, client, \"PATCH\", \"/q\", nil) = _, <nil>; want error")
 	}
 }
 
 func TestClient_get(t *testing.T) {
+	ctx := context.Background()
+
 	l, s, err := setUpTestHTTPServer(func(w http.ResponseWriter, r *http.Request) {
 		vals := r.URL.Query()
 
@@ -204,7 +495,7 @@ func TestClient_get(t *testing.T) {
 	//
 	client := testClient(l.Addr().String())
 
-	resp1, err := client.get("", nil)
+	resp1, err := client.get(ctx, "", nil)
 
 	if err != nil {
 		t.Fatalf("client.get(\"\", nil) = <nil>, %s; want <nil>", err.Error())
@@ -232,7 +523,7 @@ func TestClient_get(t *testing.T) {
 	v := url.Values{}
 	v.Set("testQuery", "set")
 
-	resp2, err := client.get("/q", v)
+	resp2, err := client.get(ctx, "/q", v)
 
 	if err != nil {
 		t.Fatalf("client.get(\"/q\", %q) = <nil>, %s; want <nil>", v, err.Error())
@@ -254,3 +545,153 @@ func TestClient_get(t *testing.T) {
 		t.Fatalf("string(body) = %s; want \"imok:set\"", bodyStr)
 	}
 }
+
+func TestClient_post(t *testing.T) {
+	ctx := context.Background()
+
+	l, s, err := setUpTestHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/x/q.json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"echo":%q}`, r.PostForm.Get("testForm"))
+	})
+
+	if err != nil {
+		t.Fatalf("setUpTestHTTPServer() = %s; want <nil>", err.Error())
+	}
+
+	defer func() {
+		s.Close()
+		l.Close()
+	}()
+
+	client := testClient(l.Addr().String())
+
+	v := url.Values{}
+	v.Set("testForm", "set")
+
+	var out struct {
+		Echo string `json:"echo"`
+	}
+
+	if err = client.post(ctx, "/q", v, &out); err != nil {
+		t.Fatalf("client.post(\"/q\", %q, &out) = %s; want <nil>", v, err.Error())
+	}
+
+	if out.Echo != "set" {
+		t.Errorf("out.Echo = %q; want %q", out.Echo, "set")
+	}
+
+	if err = client.post(ctx, "/nope", v, &out); err == nil {
+		t.Error("client.post(\"/nope\", v, &out) = <nil>; want error")
+	}
+}
+
+func TestClient_delete(t *testing.T) {
+	ctx := context.Background()
+
+	l, s, err := setUpTestHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/x/q.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err != nil {
+		t.Fatalf("setUpTestHTTPServer() = %s; want <nil>", err.Error())
+	}
+
+	defer func() {
+		s.Close()
+		l.Close()
+	}()
+
+	client := testClient(l.Addr().String())
+
+	if err = client.delete(ctx, "/q"); err != nil {
+		t.Fatalf("client.delete(\"/q\") = %s; want <nil>", err.Error())
+	}
+
+	if err = client.delete(ctx, "/nope"); err == nil {
+		t.Error("client.delete(\"/nope\") = <nil>; want error")
+	}
+}
+
+func TestClient_get_exception(t *testing.T) {
+	ctx := context.Background()
+
+	l, s, err := setUpTestHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code": 20404, "message": "not found", "status": 404}`)
+	})
+
+	if err != nil {
+		t.Fatalf("setUpTestHTTPServer() = %s; want <nil>", err.Error())
+	}
+
+	defer func() {
+		s.Close()
+		l.Close()
+	}()
+
+	client := testClient(l.Addr().String())
+
+	resp, err := client.get(ctx, "/q", nil)
+
+	if resp != nil {
+		t.Errorf("client.get(\"/q\", nil) = %#v, _; want <nil>", resp)
+	}
+
+	var e *Exception
+
+	if !errors.As(err, &e) {
+		t.Fatalf("client.get(\"/q\", nil) = _, %#v; want *Exception", err)
+	}
+
+	if e.Code != 20404 || e.Status != 404 {
+		t.Errorf("e = %#v; want Code 20404, Status 404", e)
+	}
+}
+
+func TestClient_get_context(t *testing.T) {
+	unblock := make(chan struct{})
+
+	client, done := setUpTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	})
+
+	defer done()
+	defer close(unblock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	resp, err := client.get(ctx, "/q", nil)
+
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("client.get(ctx, \"/q\", nil) = _, <nil>; want error")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("client.get(ctx, \"/q\", nil) = _, %v; want context.DeadlineExceeded", err)
+	}
+}
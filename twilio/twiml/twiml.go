@@ -0,0 +1,402 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+// Package twiml provides types for building TwiML documents, the XML
+// instructions returned to Twilio to tell it how to handle a call or message.
+//
+// A document is built by appending verbs to a Response:
+//
+//	r := twiml.NewResponse(
+//		&twiml.Gather{
+//			NumDigits: 1,
+//			Action:    "/ack",
+//			Verbs:     []twiml.Verb{&twiml.Say{Text: "Press 1 to acknowledge."}},
+//		},
+//		&twiml.Say{Text: "No input received. Goodbye."},
+//		&twiml.Hangup{},
+//	)
+//
+//	b, err := r.Marshal()
+//
+// The Response is validated before it's marshaled, to catch verbs nested where
+// Twilio does not allow them.
+package twiml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Verb is a TwiML verb that can be nested directly within a <Response>.
+type Verb interface {
+	verb() string
+}
+
+// Noun is a TwiML noun that can be nested within a <Dial>.
+type Noun interface {
+	noun() string
+}
+
+// Response is the root element of a TwiML document.
+type Response struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []Verb
+}
+
+// NewResponse returns a Response containing the verbs provided.
+func NewResponse(verbs ...Verb) *Response {
+	return &Response{Verbs: verbs}
+}
+
+// Append adds the verbs to the end of the Response.
+func (r *Response) Append(verbs ...Verb) *Response {
+	r.Verbs = append(r.Verbs, verbs...)
+	return r
+}
+
+// Validate checks that each verb is valid, and only contains elements Twilio
+// allows to be nested within it.
+func (r *Response) Validate() error {
+	for i, v := range r.Verbs {
+		if v == nil {
+			return fmt.Errorf("twiml: Response verb %d is nil", i)
+		}
+
+		if err := validate(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Marshal validates the Response and returns it as an XML document.
+func (r *Response) Marshal() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := xml.Marshal(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+// ServeHTTP implements the http.Handler interface, writing the Response as
+// the body of the HTTP response. If the Response is invalid, a 500 Internal
+// Server Error is returned instead.
+func (r *Response) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	b, err := r.Marshal()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.Write(b)
+}
+
+// validator is implemented by elements with constraints beyond their type.
+type validator interface {
+	validate() error
+}
+
+func validate(v interface{}) error {
+	if vv, ok := v.(validator); ok {
+		return vv.validate()
+	}
+
+	return nil
+}
+
+// Say reads text to the caller using text to speech.
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Text     string   `xml:",chardata"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+	Loop     int      `xml:"loop,attr,omitempty"`
+}
+
+func (*Say) verb() string { return "Say" }
+
+func (s *Say) validate() error {
+	if len(s.Text) == 0 {
+		return errors.New("twiml: Say text cannot be zero length")
+	}
+
+	return nil
+}
+
+// Play plays an audio file to the caller.
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+	URL     string   `xml:",chardata"`
+	Loop    int      `xml:"loop,attr,omitempty"`
+
+	// Digits are DTMF tones to play, instead of an audio file.
+	Digits string `xml:"digits,attr,omitempty"`
+}
+
+func (*Play) verb() string { return "Play" }
+
+func (p *Play) validate() error {
+	if len(p.URL) == 0 && len(p.Digits) == 0 {
+		return errors.New("twiml: Play must have a URL or Digits")
+	}
+
+	return nil
+}
+
+// Pause waits silently for a number of seconds.
+type Pause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr,omitempty"`
+}
+
+func (*Pause) verb() string { return "Pause" }
+
+// Gather collects digits or speech from the caller, while optionally playing
+// the nested Say, Play, and Pause verbs.
+type Gather struct {
+	XMLName       xml.Name `xml:"Gather"`
+	Action        string   `xml:"action,attr,omitempty"`
+	Method        string   `xml:"method,attr,omitempty"`
+	Timeout       int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey   string   `xml:"finishOnKey,attr,omitempty"`
+	NumDigits     int      `xml:"numDigits,attr,omitempty"`
+	Input         string   `xml:"input,attr,omitempty"`
+	Hints         string   `xml:"hints,attr,omitempty"`
+	Language      string   `xml:"language,attr,omitempty"`
+	SpeechTimeout string   `xml:"speechTimeout,attr,omitempty"`
+
+	// Verbs may only contain *Say, *Play, and *Pause.
+	Verbs []Verb
+}
+
+func (*Gather) verb() string { return "Gather" }
+
+func (g *Gather) validate() error {
+	for _, v := range g.Verbs {
+		switch v.(type) {
+		case *Say, *Play, *Pause:
+			if err := validate(v); err != nil {
+				return err
+			}
+		case nil:
+			return errors.New("twiml: Gather cannot contain a nil verb")
+		default:
+			return fmt.Errorf("twiml: Gather cannot contain <%s>", v.verb())
+		}
+	}
+
+	return nil
+}
+
+// Dial connects the caller to another party. The party is either the phone
+// number in Number, or the Nouns nested within the Dial.
+type Dial struct {
+	XMLName      xml.Name `xml:"Dial"`
+	Number       string   `xml:",chardata"`
+	Action       string   `xml:"action,attr,omitempty"`
+	Method       string   `xml:"method,attr,omitempty"`
+	Timeout      int      `xml:"timeout,attr,omitempty"`
+	HangupOnStar bool     `xml:"hangupOnStar,attr,omitempty"`
+	TimeLimit    int      `xml:"timeLimit,attr,omitempty"`
+	CallerID     string   `xml:"callerId,attr,omitempty"`
+	Record       string   `xml:"record,attr,omitempty"`
+	Nouns        []Noun
+}
+
+func (*Dial) verb() string { return "Dial" }
+
+func (d *Dial) validate() error {
+	if len(d.Number) > 0 && len(d.Nouns) > 0 {
+		return errors.New("twiml: Dial cannot have both a Number and Nouns")
+	}
+
+	if len(d.Number) == 0 && len(d.Nouns) == 0 {
+		return errors.New("twiml: Dial must have a Number or Nouns")
+	}
+
+	for _, n := range d.Nouns {
+		if n == nil {
+			return errors.New("twiml: Dial cannot contain a nil noun")
+		}
+
+		if err := validate(n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Record records the caller's voice.
+type Record struct {
+	XMLName                 xml.Name `xml:"Record"`
+	Action                  string   `xml:"action,attr,omitempty"`
+	Method                  string   `xml:"method,attr,omitempty"`
+	Timeout                 int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey             string   `xml:"finishOnKey,attr,omitempty"`
+	MaxLength               int      `xml:"maxLength,attr,omitempty"`
+	PlayBeep                *bool    `xml:"playBeep,attr,omitempty"`
+	Trim                    string   `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback string   `xml:"recordingStatusCallback,attr,omitempty"`
+	Transcribe              bool     `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback      string   `xml:"transcribeCallback,attr,omitempty"`
+}
+
+func (*Record) verb() string { return "Record" }
+
+// Redirect transfers control of the call or message to the TwiML at URL.
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	URL     string   `xml:",chardata"`
+	Method  string   `xml:"method,attr,omitempty"`
+}
+
+func (*Redirect) verb() string { return "Redirect" }
+
+func (r *Redirect) validate() error {
+	if len(r.URL) == 0 {
+		return errors.New("twiml: Redirect URL cannot be zero length")
+	}
+
+	return nil
+}
+
+// Hangup ends the call.
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+func (*Hangup) verb() string { return "Hangup" }
+
+// Enqueue places the caller in to a call queue.
+type Enqueue struct {
+	XMLName       xml.Name `xml:"Enqueue"`
+	Name          string   `xml:",chardata"`
+	Action        string   `xml:"action,attr,omitempty"`
+	Method        string   `xml:"method,attr,omitempty"`
+	WaitURL       string   `xml:"waitUrl,attr,omitempty"`
+	WaitURLMethod string   `xml:"waitUrlMethod,attr,omitempty"`
+	WorkflowSID   string   `xml:"workflowSid,attr,omitempty"`
+}
+
+func (*Enqueue) verb() string { return "Enqueue" }
+
+func (e *Enqueue) validate() error {
+	if len(e.Name) == 0 && len(e.WorkflowSID) == 0 {
+		return errors.New("twiml: Enqueue must have a Name or WorkflowSID")
+	}
+
+	return nil
+}
+
+// Message sends an SMS or MMS message in reply to an incoming message, or
+// during a call.
+type Message struct {
+	XMLName        xml.Name `xml:"Message"`
+	To             string   `xml:"to,attr,omitempty"`
+	From           string   `xml:"from,attr,omitempty"`
+	Action         string   `xml:"action,attr,omitempty"`
+	Method         string   `xml:"method,attr,omitempty"`
+	StatusCallback string   `xml:"statusCallback,attr,omitempty"`
+	Body           string   `xml:"Body,omitempty"`
+	Media          []string `xml:"Media"`
+}
+
+func (*Message) verb() string { return "Message" }
+
+func (m *Message) validate() error {
+	if len(m.Body) == 0 && len(m.Media) == 0 {
+		return errors.New("twiml: Message must have a Body or Media")
+	}
+
+	return nil
+}
+
+// Number is a phone number to <Dial>.
+type Number struct {
+	XMLName             xml.Name `xml:"Number"`
+	Number              string   `xml:",chardata"`
+	SendDigits          string   `xml:"sendDigits,attr,omitempty"`
+	URL                 string   `xml:"url,attr,omitempty"`
+	Method              string   `xml:"method,attr,omitempty"`
+	StatusCallback      string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent string   `xml:"statusCallbackEvent,attr,omitempty"`
+}
+
+func (*Number) noun() string { return "Number" }
+
+func (n *Number) validate() error {
+	if len(n.Number) == 0 {
+		return errors.New("twiml: Number cannot be zero length")
+	}
+
+	return nil
+}
+
+// Client is a Twilio Client identifier to <Dial>.
+type Client struct {
+	XMLName xml.Name `xml:"Client"`
+	Name    string   `xml:",chardata"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+}
+
+func (*Client) noun() string { return "Client" }
+
+// Conference is a conference room to <Dial> in to.
+type Conference struct {
+	XMLName                xml.Name `xml:"Conference"`
+	Name                   string   `xml:",chardata"`
+	Muted                  bool     `xml:"muted,attr,omitempty"`
+	Beep                   string   `xml:"beep,attr,omitempty"`
+	StartConferenceOnEnter *bool    `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndConferenceOnExit    bool     `xml:"endConferenceOnExit,attr,omitempty"`
+	WaitURL                string   `xml:"waitUrl,attr,omitempty"`
+	MaxParticipants        int      `xml:"maxParticipants,attr,omitempty"`
+	Record                 string   `xml:"record,attr,omitempty"`
+	StatusCallback         string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent    string   `xml:"statusCallbackEvent,attr,omitempty"`
+}
+
+func (*Conference) noun() string { return "Conference" }
+
+func (c *Conference) validate() error {
+	if len(c.Name) == 0 {
+		return errors.New("twiml: Conference name cannot be zero length")
+	}
+
+	return nil
+}
+
+// Queue is a call queue to <Dial>, connecting the caller to the member at the
+// front of the queue.
+type Queue struct {
+	XMLName xml.Name `xml:"Queue"`
+	Name    string   `xml:",chardata"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+}
+
+func (*Queue) noun() string { return "Queue" }
+
+func (q *Queue) validate() error {
+	if len(q.Name) == 0 {
+		return errors.New("twiml: Queue name cannot be zero length")
+	}
+
+	return nil
+}
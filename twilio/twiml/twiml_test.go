@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twiml
+
+import (
+	"encoding/xml"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponse_Marshal(t *testing.T) {
+	tests := []struct {
+		in   *Response
+		out  string
+		desc string
+	}{
+		{in: NewResponse(), out: `<Response></Response>`, desc: `empty responses are valid`},
+		{
+			in: NewResponse(
+				&Gather{
+					Action:    "/ack",
+					NumDigits: 1,
+					Verbs:     []Verb{&Say{Text: "Press 1 to acknowledge.", Voice: "alice"}, &Pause{Length: 2}},
+				},
+				&Say{Text: "Goodbye & good luck."},
+				&Hangup{},
+			),
+			out:  `<Response><Gather action="/ack" numDigits="1"><Say voice="alice">Press 1 to acknowledge.</Say><Pause length="2"></Pause></Gather><Say>Goodbye &amp; good luck.</Say><Hangup></Hangup></Response>`,
+			desc: `the acknowledgement call flow`,
+		},
+		{
+			in: NewResponse(&Dial{
+				CallerID: "+15005550006",
+				Nouns:    []Noun{&Conference{Name: "INC-42", EndConferenceOnExit: true}, &Number{Number: "+15005550001"}},
+			}),
+			out:  `<Response><Dial callerId="+15005550006"><Conference endConferenceOnExit="true">INC-42</Conference><Number>+15005550001</Number></Dial></Response>`,
+			desc: `Dial should contain its nouns`,
+		},
+		{
+			in:   NewResponse(&Message{Body: "ack received", Media: []string{"https://example.org/1.png"}}),
+			out:  `<Response><Message><Body>ack received</Body><Media>https://example.org/1.png</Media></Message></Response>`,
+			desc: `Message should contain Body and Media nouns`,
+		},
+		{
+			in:   NewResponse(&Enqueue{Name: "support", WaitURL: "/wait"}, &Redirect{URL: "/next", Method: "POST"}),
+			out:  `<Response><Enqueue waitUrl="/wait">support</Enqueue><Redirect method="POST">/next</Redirect></Response>`,
+			desc: `Enqueue and Redirect should use their text content`,
+		},
+	}
+
+	for _, tt := range tests {
+		b, err := tt.in.Marshal()
+
+		if err != nil {
+			t.Errorf("\nDescription: %s\nr.Marshal() = _, %s; want <nil>", tt.desc, err)
+			continue
+		}
+
+		if want := xml.Header + tt.out; string(b) != want {
+			t.Errorf("\nDescription: %s\nr.Marshal() = %s; want %s", tt.desc, b, want)
+		}
+	}
+}
+
+func TestResponse_Validate(t *testing.T) {
+	tests := []struct {
+		in   *Response
+		desc string
+	}{
+		{in: NewResponse(&Gather{Verbs: []Verb{&Dial{Number: "+15005550001"}}}), desc: `Gather cannot contain Dial`},
+		{in: NewResponse(&Gather{Verbs: []Verb{&Gather{}}}), desc: `Gather cannot contain Gather`},
+		{in: NewResponse(&Gather{Verbs: []Verb{&Say{}}}), desc: `nested verbs should be validated`},
+		{in: NewResponse(&Dial{}), desc: `Dial must have something to dial`},
+		{in: NewResponse(&Dial{Number: "+15005550001", Nouns: []Noun{&Client{Name: "x"}}}), desc: `Dial cannot have a Number and Nouns`},
+		{in: NewResponse(&Message{}), desc: `Message must have a Body or Media`},
+		{in: NewResponse(nil), desc: `verbs cannot be nil`},
+	}
+
+	for _, tt := range tests {
+		if err := tt.in.Validate(); err == nil {
+			t.Errorf("\nDescription: %s\nr.Validate() = <nil>; want error", tt.desc)
+		}
+
+		if _, err := tt.in.Marshal(); err == nil {
+			t.Errorf("\nDescription: %s\nr.Marshal() = _, <nil>; want error", tt.desc)
+		}
+	}
+}
+
+func TestResponse_ServeHTTP(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewResponse(&Hangup{}).ServeHTTP(w, httptest.NewRequest("POST", "/", nil))
+
+	if w.Code != 200 {
+		t.Errorf("w.Code = %d; want 200", w.Code)
+	}
+
+	if ct := w.Header().Get("Content-Type"); ct != "text/xml; charset=utf-8" {
+		t.Errorf("Content-Type = %q; want %q", ct, "text/xml; charset=utf-8")
+	}
+
+	w = httptest.NewRecorder()
+	NewResponse(&Say{}).ServeHTTP(w, httptest.NewRequest("POST", "/", nil))
+
+	if w.Code != 500 {
+		t.Errorf("w.Code = %d; want 500", w.Code)
+	}
+}
@@ -9,6 +9,7 @@ package twilio
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -77,3 +78,40 @@ func (t Time) MarshalJSON() ([]byte, error) {
 
 	return b, nil
 }
+
+// Float is a float64 that unmarshals from either a JSON number or a JSON
+// string. Twilio returns many numeric values, such as prices and usage
+// amounts, as quoted strings. A null or empty value unmarshals to zero.
+//
+// This type also provides a Float64() method for returning a float64 value.
+type Float float64
+
+// Float64 is a method to convert a twilio.Float value in to a float64 value.
+func (f Float) Float64() float64 { return float64(f) }
+
+// UnmarshalJSON implements the json.Unmarshaler interface. This accepts both
+// quoted and unquoted numbers.
+func (f *Float) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), "\"")
+
+	if s == "null" || len(s) == 0 {
+		*f = 0
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+
+	if err != nil {
+		return err
+	}
+
+	*f = Float(v)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. This marshals the value
+// as a quoted string, which is how Twilio represents it.
+func (f Float) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatFloat(float64(f), 'f', -1, 64))), nil
+}
@@ -108,3 +108,39 @@ func testTimeUnmarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		out  Float
+		err  bool
+		desc string
+	}{
+		{in: []byte(`"12.345"`), out: 12.345, desc: `quoted numbers should be parsed`},
+		{in: []byte(`-0.75`), out: -0.75, desc: `unquoted numbers should be parsed`},
+		{in: []byte(`null`), out: 0, desc: `null should be zero`},
+		{in: []byte(`""`), out: 0, desc: `an empty string should be zero`},
+		{in: []byte(`"twelve"`), err: true, desc: `non-numeric strings are invalid`},
+	}
+
+	for _, test := range tests {
+		var f Float
+
+		err := f.UnmarshalJSON(test.in)
+
+		if test.err != (err != nil) {
+			t.Errorf("\nDescription: %s\nFloat.UnmarshalJSON(%s) = %v; want error: %t", test.desc, test.in, err, test.err)
+			continue
+		}
+
+		if f.Float64() != test.out.Float64() {
+			t.Errorf("\nDescription: %s\nFloat.UnmarshalJSON(%s) = %v; want %v", test.desc, test.in, f, test.out)
+		}
+	}
+
+	b, err := Float(1.5).MarshalJSON()
+
+	if err != nil || string(b) != `"1.5"` {
+		t.Errorf("Float(1.5).MarshalJSON() = %s, %v; want \"1.5\", <nil>", b, err)
+	}
+}
@@ -0,0 +1,338 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// UsageTriggerBy is the field of a usage record that a usage trigger compares
+// against its TriggerValue.
+type UsageTriggerBy string
+
+// The fields of a usage record that a usage trigger can compare.
+const (
+	UsageTriggerByCount UsageTriggerBy = "count"
+	UsageTriggerByUsage UsageTriggerBy = "usage"
+	UsageTriggerByPrice UsageTriggerBy = "price"
+)
+
+// UsageRecurring is how often a usage trigger resets.
+type UsageRecurring string
+
+// The intervals at which a usage trigger can reset. The empty value means the
+// trigger fires only once.
+const (
+	UsageRecurringDaily   UsageRecurring = "daily"
+	UsageRecurringMonthly UsageRecurring = "monthly"
+	UsageRecurringYearly  UsageRecurring = "yearly"
+	UsageRecurringAllTime UsageRecurring = "alltime"
+)
+
+// UsageService groups the services of the Usage resource of the Twilio API.
+type UsageService struct {
+	// Records is used to report on the account's usage.
+	Records *UsageRecordsService
+
+	// Triggers is used to manage webhooks fired when usage crosses a
+	// threshold.
+	Triggers *UsageTriggersService
+}
+
+// UsageRecordsService provides access to the Usage Records resource of the
+// Twilio API. Each method lists the same records, grouped over a different
+// period of time.
+type UsageRecordsService struct {
+	client *Client
+}
+
+// UsageRecordListParams are the filters used when listing usage records. All
+// fields are optional.
+type UsageRecordListParams struct {
+	// Only show usage of this category (e.g., sms, calls, totalprice).
+	Category string
+
+	// Only show usage on or after this date.
+	StartDate time.Time
+
+	// Only show usage on or before this date.
+	EndDate time.Time
+
+	// Include the usage of the account's subaccounts. Defaults to true.
+	IncludeSubaccounts *bool
+
+	ListOptions
+}
+
+func (p *UsageRecordListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "Category", p.Category)
+	setDate(v, "StartDate", p.StartDate)
+	setDate(v, "EndDate", p.EndDate)
+	setBool(v, "IncludeSubaccounts", p.IncludeSubaccounts)
+
+	return v
+}
+
+func (s *UsageRecordsService) list(resource string, params *UsageRecordListParams) *Iterator[UsageRecord] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[UsageRecord](s.client, resource, "usage_records", params.values(), opts)
+}
+
+// List returns an iterator over usage records covering the whole date range
+// in params, with one record per category. The params value may be nil.
+func (s *UsageRecordsService) List(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records", params)
+}
+
+// Daily returns an iterator over usage records with one record per category
+// per day.
+func (s *UsageRecordsService) Daily(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/Daily", params)
+}
+
+// Monthly returns an iterator over usage records with one record per category
+// per month.
+func (s *UsageRecordsService) Monthly(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/Monthly", params)
+}
+
+// Yearly returns an iterator over usage records with one record per category
+// per year.
+func (s *UsageRecordsService) Yearly(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/Yearly", params)
+}
+
+// Today returns an iterator over usage records for the current day.
+func (s *UsageRecordsService) Today(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/Today", params)
+}
+
+// Yesterday returns an iterator over usage records for the previous day.
+func (s *UsageRecordsService) Yesterday(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/Yesterday", params)
+}
+
+// ThisMonth returns an iterator over usage records for the current month.
+func (s *UsageRecordsService) ThisMonth(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/ThisMonth", params)
+}
+
+// LastMonth returns an iterator over usage records for the previous month.
+func (s *UsageRecordsService) LastMonth(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/LastMonth", params)
+}
+
+// AllTime returns an iterator over usage records covering the lifetime of the
+// account.
+func (s *UsageRecordsService) AllTime(params *UsageRecordListParams) *Iterator[UsageRecord] {
+	return s.list("/Usage/Records/AllTime", params)
+}
+
+// UsageTriggersService provides access to the Usage Triggers resource of the
+// Twilio API, which is used to manage webhooks Twilio calls once usage crosses
+// a threshold.
+type UsageTriggersService struct {
+	client *Client
+}
+
+// UsageTriggerParams are the parameters used to create a usage trigger.
+// CallbackURL, TriggerValue, and UsageCategory are required.
+type UsageTriggerParams struct {
+	// The URL Twilio requests when the trigger fires.
+	CallbackURL string
+
+	// The HTTP method Twilio uses to request CallbackURL. Defaults to POST.
+	CallbackMethod string
+
+	// A human readable description of the trigger.
+	FriendlyName string
+
+	// The usage category to watch (e.g., sms, calls, totalprice).
+	UsageCategory string
+
+	// The value at which the trigger fires. This may be prefixed with + to
+	// make it relative to the current value (e.g., +30).
+	TriggerValue string
+
+	// The field of the usage record to compare against TriggerValue. Either
+	// count, usage, or price. Defaults to usage.
+	TriggerBy UsageTriggerBy
+
+	// How often the trigger resets. If empty, the trigger fires only once.
+	Recurring UsageRecurring
+}
+
+func (p *UsageTriggerParams) validate() error {
+	if p == nil {
+		return errors.New("*UsageTriggerParams cannot be nil")
+	}
+
+	if len(p.CallbackURL) == 0 {
+		return errors.New("CallbackURL cannot be zero length")
+	}
+
+	if len(p.TriggerValue) == 0 {
+		return errors.New("TriggerValue cannot be zero length")
+	}
+
+	if len(p.UsageCategory) == 0 {
+		return errors.New("UsageCategory cannot be zero length")
+	}
+
+	return nil
+}
+
+func (p *UsageTriggerParams) values() url.Values {
+	v := url.Values{}
+
+	v.Set("CallbackUrl", p.CallbackURL)
+	v.Set("TriggerValue", p.TriggerValue)
+	v.Set("UsageCategory", p.UsageCategory)
+
+	setString(v, "CallbackMethod", p.CallbackMethod)
+	setString(v, "FriendlyName", p.FriendlyName)
+	setString(v, "TriggerBy", string(p.TriggerBy))
+	setString(v, "Recurring", string(p.Recurring))
+
+	return v
+}
+
+// UsageTriggerUpdateParams are the parameters used to update a usage trigger.
+// Only the fields that are set are changed.
+type UsageTriggerUpdateParams struct {
+	// The URL Twilio requests when the trigger fires.
+	CallbackURL string
+
+	// The HTTP method Twilio uses to request CallbackURL.
+	CallbackMethod string
+
+	// A human readable description of the trigger.
+	FriendlyName string
+}
+
+func (p *UsageTriggerUpdateParams) values() url.Values {
+	v := url.Values{}
+
+	setString(v, "CallbackUrl", p.CallbackURL)
+	setString(v, "CallbackMethod", p.CallbackMethod)
+	setString(v, "FriendlyName", p.FriendlyName)
+
+	return v
+}
+
+// UsageTriggerListParams are the filters used when listing usage triggers. All
+// fields are optional.
+type UsageTriggerListParams struct {
+	// Only show triggers with this recurrence.
+	Recurring UsageRecurring
+
+	// Only show triggers that compare this field.
+	TriggerBy UsageTriggerBy
+
+	// Only show triggers that watch this usage category.
+	UsageCategory string
+
+	ListOptions
+}
+
+func (p *UsageTriggerListParams) values() url.Values {
+	v := url.Values{}
+
+	if p == nil {
+		return v
+	}
+
+	setString(v, "Recurring", string(p.Recurring))
+	setString(v, "TriggerBy", string(p.TriggerBy))
+	setString(v, "UsageCategory", p.UsageCategory)
+
+	return v
+}
+
+// Create creates a new usage trigger.
+func (s *UsageTriggersService) Create(ctx context.Context, params *UsageTriggerParams) (*UsageTrigger, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	trigger := &UsageTrigger{}
+
+	if err := s.client.post(ctx, "/Usage/Triggers", params.values(), trigger); err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// Get fetches the usage trigger with the given SID.
+func (s *UsageTriggersService) Get(ctx context.Context, sid string) (*UsageTrigger, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	trigger := &UsageTrigger{}
+
+	if err := s.client.getJSON(ctx, resourcePath("Usage", "Triggers", sid), nil, trigger); err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// List returns an iterator over the usage triggers matching the filters in
+// params. The params value may be nil to list all triggers.
+func (s *UsageTriggersService) List(params *UsageTriggerListParams) *Iterator[UsageTrigger] {
+	var opts ListOptions
+
+	if params != nil {
+		opts = params.ListOptions
+	}
+
+	return newIterator[UsageTrigger](s.client, "/Usage/Triggers", "usage_triggers", params.values(), opts)
+}
+
+// Update modifies the usage trigger with the given SID.
+func (s *UsageTriggersService) Update(ctx context.Context, sid string, params *UsageTriggerUpdateParams) (*UsageTrigger, error) {
+	if len(sid) == 0 {
+		return nil, errors.New("sid cannot be zero length")
+	}
+
+	if params == nil {
+		return nil, errors.New("*UsageTriggerUpdateParams cannot be nil")
+	}
+
+	trigger := &UsageTrigger{}
+
+	if err := s.client.post(ctx, resourcePath("Usage", "Triggers", sid), params.values(), trigger); err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// Delete removes the usage trigger with the given SID.
+func (s *UsageTriggersService) Delete(ctx context.Context, sid string) error {
+	if len(sid) == 0 {
+		return errors.New("sid cannot be zero length")
+	}
+
+	return s.client.delete(ctx, resourcePath("Usage", "Triggers", sid))
+}
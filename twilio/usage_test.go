@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUsageRecordsService(t *testing.T) {
+	// records lists the usage records of the subresource named by list
+	records := func(list func(s *UsageRecordsService, p *UsageRecordListParams) *Iterator[UsageRecord], p *UsageRecordListParams) func(ctx context.Context, c *Client) ([]UsageRecord, error) {
+		return func(ctx context.Context, c *Client) ([]UsageRecord, error) {
+			return collect(ctx, list(c.Usage.Records, p))
+		}
+	}
+
+	totalprice := `{"usage_records": [{"category": "totalprice", "price": 12.5, "usage": null}], "next_page_uri": null}`
+	want := []UsageRecord{{Category: "totalprice", Price: 12.5}}
+
+	tests := []serviceTest[[]UsageRecord]{
+		{
+			call: records((*UsageRecordsService).Daily, &UsageRecordListParams{
+				Category:  "sms",
+				StartDate: time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC),
+			}),
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Usage/Records/Daily.json", form: form("Category", "sms", "StartDate", "2017-03-01", "EndDate", "2017-03-02"),
+					body: `{"usage_records": [
+						{"category": "sms", "start_date": "2017-03-01", "count": "10", "usage": "10", "price": "0.075", "price_unit": "usd"},
+						{"category": "sms", "start_date": "2017-03-02", "count": "4", "usage": "4", "price": "0.03", "price_unit": "usd"}
+					], "next_page_uri": null}`,
+				},
+			},
+			out: []UsageRecord{
+				{Category: "sms", StartDate: "2017-03-01", Count: 10, Usage: 10, Price: 0.075, PriceUnit: "usd"},
+				{Category: "sms", StartDate: "2017-03-02", Count: 4, Usage: 4, Price: 0.03, PriceUnit: "usd"},
+			},
+			desc: `daily records should be filtered by category and date`,
+		},
+	}
+
+	for _, sub := range []struct {
+		name string
+		list func(s *UsageRecordsService, p *UsageRecordListParams) *Iterator[UsageRecord]
+		path string
+	}{
+		{"List", (*UsageRecordsService).List, "/x/Usage/Records.json"},
+		{"Monthly", (*UsageRecordsService).Monthly, "/x/Usage/Records/Monthly.json"},
+		{"Yearly", (*UsageRecordsService).Yearly, "/x/Usage/Records/Yearly.json"},
+		{"Today", (*UsageRecordsService).Today, "/x/Usage/Records/Today.json"},
+		{"Yesterday", (*UsageRecordsService).Yesterday, "/x/Usage/Records/Yesterday.json"},
+		{"ThisMonth", (*UsageRecordsService).ThisMonth, "/x/Usage/Records/ThisMonth.json"},
+		{"LastMonth", (*UsageRecordsService).LastMonth, "/x/Usage/Records/LastMonth.json"},
+		{"AllTime", (*UsageRecordsService).AllTime, "/x/Usage/Records/AllTime.json"},
+	} {
+		tests = append(tests, serviceTest[[]UsageRecord]{
+			call:      records(sub.list, nil),
+			exchanges: []exchange{{method: "GET", path: sub.path, body: totalprice}},
+			out:       want,
+			desc:      sub.name + ` should list the records of its subresource, with a null usage as zero`,
+		})
+	}
+
+	testService(t, tests)
+}
+
+func TestUsageTriggersService_Create(t *testing.T) {
+	testService(t, []serviceTest[*UsageTrigger]{
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Create(ctx, &UsageTriggerParams{
+					CallbackURL:   "https://example.org/usage",
+					UsageCategory: "totalprice",
+					TriggerBy:     UsageTriggerByPrice,
+					TriggerValue:  "50",
+					Recurring:     UsageRecurringDaily,
+				})
+			},
+			exchanges: []exchange{
+				{
+					method: "POST", path: "/x/Usage/Triggers.json",
+					form: form(
+						"CallbackUrl", "https://example.org/usage", "UsageCategory", "totalprice",
+						"TriggerBy", "price", "TriggerValue", "50", "Recurring", "daily",
+					),
+					status: http.StatusCreated,
+					body: `{"sid": "UT1", "usage_category": "totalprice", "trigger_by": "price", "recurring": "daily",
+						"trigger_value": "50", "current_value": "12.25"}`,
+				},
+			},
+			out: &UsageTrigger{
+				SID: "UT1", UsageCategory: "totalprice", TriggerBy: UsageTriggerByPrice, Recurring: UsageRecurringDaily,
+				TriggerValue: 50, CurrentValue: 12.25,
+			},
+			desc: `the trigger should be created`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Create(ctx, &UsageTriggerParams{CallbackURL: "https://example.org/usage", UsageCategory: "totalprice"})
+			},
+			err:  true,
+			desc: `an empty TriggerValue should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Create(ctx, &UsageTriggerParams{CallbackURL: "https://example.org/usage", TriggerValue: "50"})
+			},
+			err:  true,
+			desc: `an empty UsageCategory should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Create(ctx, nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+	})
+}
+
+func TestUsageTriggersService_Get(t *testing.T) {
+	testService(t, []serviceTest[*UsageTrigger]{
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Get(ctx, "UT1")
+			},
+			exchanges: []exchange{
+				{method: "GET", path: "/x/Usage/Triggers/UT1.json", body: `{"sid": "UT1", "callback_url": "https://example.org/usage"}`},
+			},
+			out:  &UsageTrigger{SID: "UT1", CallbackURL: "https://example.org/usage"},
+			desc: `the trigger should be fetched`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Get(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestUsageTriggersService_List(t *testing.T) {
+	testService(t, []serviceTest[[]UsageTrigger]{
+		{
+			call: func(ctx context.Context, c *Client) ([]UsageTrigger, error) {
+				return collect(ctx, c.Usage.Triggers.List(&UsageTriggerListParams{Recurring: UsageRecurringDaily, TriggerBy: UsageTriggerByCount}))
+			},
+			exchanges: []exchange{
+				{
+					method: "GET", path: "/x/Usage/Triggers.json", form: form("Recurring", "daily", "TriggerBy", "count"),
+					body: `{"usage_triggers": [{"sid": "UT1"}], "next_page_uri": null}`,
+				},
+			},
+			out:  []UsageTrigger{{SID: "UT1"}},
+			desc: `triggers should be filtered by recurrence and field`,
+		},
+	})
+}
+
+func TestUsageTriggersService_Update(t *testing.T) {
+	testService(t, []serviceTest[*UsageTrigger]{
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Update(ctx, "UT1", &UsageTriggerUpdateParams{FriendlyName: "paging spend"})
+			},
+			exchanges: []exchange{
+				{method: "POST", path: "/x/Usage/Triggers/UT1.json", form: form("FriendlyName", "paging spend"), body: `{"sid": "UT1", "friendly_name": "paging spend"}`},
+			},
+			out:  &UsageTrigger{SID: "UT1", FriendlyName: "paging spend"},
+			desc: `only the fields that are set should be sent`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Update(ctx, "UT1", nil)
+			},
+			err:  true,
+			desc: `nil params should be rejected`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (*UsageTrigger, error) {
+				return c.Usage.Triggers.Update(ctx, "", &UsageTriggerUpdateParams{FriendlyName: "paging spend"})
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
+
+func TestUsageTriggersService_Delete(t *testing.T) {
+	testService(t, []serviceTest[struct{}]{
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Usage.Triggers.Delete(ctx, "UT1")
+			},
+			exchanges: []exchange{
+				{method: "DELETE", path: "/x/Usage/Triggers/UT1.json", status: http.StatusNoContent},
+			},
+			desc: `the trigger should be deleted`,
+		},
+		{
+			call: func(ctx context.Context, c *Client) (struct{}, error) {
+				return struct{}{}, c.Usage.Triggers.Delete(ctx, "")
+			},
+			err:  true,
+			desc: `an empty sid should be rejected`,
+		},
+	})
+}
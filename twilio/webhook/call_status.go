@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/theckman/houston/twilio"
+)
+
+// CallStatusCallback is the payload of the webhook Twilio sends to the
+// StatusCallback of a call for each of its StatusCallbackEvents: initiated,
+// ringing, answered, and completed.
+type CallStatusCallback struct {
+	// A 34 character string that uniquely identifies the call.
+	CallSID string
+
+	// The unique id of the Account the call belongs to.
+	AccountSID string
+
+	// The SID of the call that spawned this one, if any.
+	ParentCallSID string
+
+	// The phone number, SIP address, or client identifier of the caller.
+	From string
+
+	// The phone number, SIP address, or client identifier that was called.
+	To string
+
+	// The direction of the call (e.g., outbound-api).
+	Direction string
+
+	// The status of the call when the event happened. The answered event has
+	// a status of in-progress.
+	CallStatus twilio.CallStatus
+
+	// The length of the call in seconds. Only set once the call has ended.
+	CallDuration int
+
+	// Whether a human or a machine answered the call, if machine detection
+	// was enabled (e.g., human or machine_start).
+	AnsweredBy string
+
+	// The order of this event among all the events for the call, starting at
+	// zero. Callbacks may arrive out of order.
+	SequenceNumber int
+
+	// When the event happened.
+	Timestamp time.Time
+}
+
+// ParseCallStatusCallback parses the form fields of a call status callback
+// webhook.
+func ParseCallStatusCallback(r *http.Request) (*CallStatusCallback, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	cb := &CallStatusCallback{
+		CallSID:       f.Get("CallSid"),
+		AccountSID:    f.Get("AccountSid"),
+		ParentCallSID: f.Get("ParentCallSid"),
+		From:          f.Get("From"),
+		To:            f.Get("To"),
+		Direction:     f.Get("Direction"),
+		CallStatus:    twilio.CallStatus(f.Get("CallStatus")),
+		AnsweredBy:    f.Get("AnsweredBy"),
+	}
+
+	if len(cb.CallSID) == 0 {
+		return nil, fmt.Errorf("webhook: status callback has no CallSid")
+	}
+
+	if !cb.CallStatus.Valid() {
+		return nil, fmt.Errorf("webhook: unknown CallStatus %q", cb.CallStatus)
+	}
+
+	var err error
+
+	if cb.CallDuration, err = formInt(f, "CallDuration"); err != nil {
+		return nil, err
+	}
+
+	if cb.SequenceNumber, err = formInt(f, "SequenceNumber"); err != nil {
+		return nil, err
+	}
+
+	if s := f.Get("Timestamp"); len(s) > 0 {
+		if cb.Timestamp, err = time.Parse(time.RFC1123Z, s); err != nil {
+			return nil, fmt.Errorf("webhook: invalid Timestamp %q: %s", s, err)
+		}
+	}
+
+	return cb, nil
+}
+
+// CallStatusHook is a function called for a call status callback.
+type CallStatusHook func(cb *CallStatusCallback)
+
+// CallStatusHandler is an http.Handler for call status callbacks, which calls
+// the hooks registered for the status of each callback. The zero value is
+// ready to use, and it is safe for concurrent use.
+type CallStatusHandler struct {
+	mu    sync.RWMutex
+	hooks map[twilio.CallStatus][]CallStatusHook
+}
+
+// On registers a hook to be called when a callback with the given status is
+// received. Hooks are called in the order they were registered.
+func (h *CallStatusHandler) On(status twilio.CallStatus, hook CallStatusHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.hooks == nil {
+		h.hooks = make(map[twilio.CallStatus][]CallStatusHook)
+	}
+
+	h.hooks[status] = append(h.hooks[status], hook)
+}
+
+// OnEnded registers a hook to be called when a callback is received with any
+// status that means the call has ended.
+func (h *CallStatusHandler) OnEnded(hook CallStatusHook) {
+	for _, s := range []twilio.CallStatus{
+		twilio.CallStatusCompleted, twilio.CallStatusBusy, twilio.CallStatusFailed,
+		twilio.CallStatusNoAnswer, twilio.CallStatusCanceled,
+	} {
+		h.On(s, hook)
+	}
+}
+
+// ServeHTTP implements the http.Handler interface. Callbacks that can't be
+// parsed get a 400 Bad Request, while all others get a 204 No Content.
+func (h *CallStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cb, err := ParseCallStatusCallback(r)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.Dispatch(cb)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Dispatch calls the hooks registered for the status in cb.
+func (h *CallStatusHandler) Dispatch(cb *CallStatusCallback) {
+	h.mu.RLock()
+	hooks := h.hooks[cb.CallStatus]
+	h.mu.RUnlock()
+
+	for _, hook := range hooks {
+		hook(cb)
+	}
+}
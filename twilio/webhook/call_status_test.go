@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/theckman/houston/twilio"
+)
+
+func testCallStatusRequest(status twilio.CallStatus) *http.Request {
+	v := url.Values{}
+	v.Set("CallSid", "CA1")
+	v.Set("CallStatus", string(status))
+	v.Set("SequenceNumber", "3")
+	v.Set("Timestamp", "Thu, 01 Jan 1970 00:00:00 +0000")
+
+	if status == twilio.CallStatusCompleted {
+		v.Set("CallDuration", "42")
+		v.Set("AnsweredBy", "human")
+	}
+
+	return testPostRequest(v)
+}
+
+func TestParseCallStatusCallback(t *testing.T) {
+	cb, err := ParseCallStatusCallback(testCallStatusRequest(twilio.CallStatusCompleted))
+
+	if err != nil {
+		t.Fatalf("ParseCallStatusCallback() = _, %s; want <nil>", err)
+	}
+
+	if cb.CallSID != "CA1" || cb.CallStatus != twilio.CallStatusCompleted || cb.CallDuration != 42 || cb.AnsweredBy != "human" {
+		t.Errorf("cb = %+v; want CA1 completed after 42s, answered by a human", cb)
+	}
+
+	if cb.SequenceNumber != 3 || !cb.Timestamp.Equal(time.Unix(0, 0)) {
+		t.Errorf("cb = %+v; want SequenceNumber 3 at the UNIX epoch", cb)
+	}
+
+	if _, err = ParseCallStatusCallback(testCallStatusRequest("hung-up")); err == nil {
+		t.Error("ParseCallStatusCallback() with unknown status = _, <nil>; want error")
+	}
+}
+
+func TestCallStatusHandler(t *testing.T) {
+	var h CallStatusHandler
+	var escalated, ended []twilio.CallStatus
+
+	escalate := func(cb *CallStatusCallback) { escalated = append(escalated, cb.CallStatus) }
+
+	h.On(twilio.CallStatusNoAnswer, escalate)
+	h.On(twilio.CallStatusBusy, escalate)
+	h.OnEnded(func(cb *CallStatusCallback) { ended = append(ended, cb.CallStatus) })
+
+	for _, s := range []twilio.CallStatus{
+		twilio.CallStatusInitiated, twilio.CallStatusRinging,
+		twilio.CallStatusNoAnswer, twilio.CallStatusBusy, twilio.CallStatusCompleted,
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, testCallStatusRequest(s))
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("%s: w.Code = %d; want %d", s, w.Code, http.StatusNoContent)
+		}
+	}
+
+	if len(escalated) != 2 || escalated[0] != twilio.CallStatusNoAnswer || escalated[1] != twilio.CallStatusBusy {
+		t.Errorf("escalated = %v; want [no-answer busy]", escalated)
+	}
+
+	if len(ended) != 3 {
+		t.Errorf("ended = %v; want [no-answer busy completed]", ended)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, testCallStatusRequest("hung-up"))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("w.Code = %d; want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,257 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/theckman/houston/twilio"
+)
+
+// Location is the geographic data Twilio looks up for a phone number, when it
+// is available.
+type Location struct {
+	City    string
+	State   string
+	Zip     string
+	Country string
+}
+
+// MaxMedia is the maximum number of media files Twilio attaches to a single
+// message. ParseIncomingMessage rejects a NumMedia outside of [0,MaxMedia].
+const MaxMedia = 10
+
+// Media is a media file attached to an incoming MMS message.
+type Media struct {
+	// The URL where the media file can be fetched.
+	URL string
+
+	// The MIME type of the media file (e.g., image/jpeg).
+	ContentType string
+}
+
+// IncomingMessage is the payload of the webhook Twilio sends when one of your
+// phone numbers receives an SMS or MMS message.
+type IncomingMessage struct {
+	// A 34 character string that uniquely identifies the message.
+	MessageSID string
+
+	// The unique id of the Account the message was sent to.
+	AccountSID string
+
+	// The unique id of the Messaging Service the message was sent to, if any.
+	MessagingServiceSID string
+
+	// The phone number that sent the message.
+	From string
+
+	// The phone number that received the message.
+	To string
+
+	// The text body of the message.
+	Body string
+
+	// The number of segments the message was split in to.
+	NumSegments int
+
+	// The number of media files attached to the message.
+	NumMedia int
+
+	// The media files attached to the message, in order. This is built from
+	// the numbered MediaUrlN and MediaContentTypeN fields.
+	Media []Media
+
+	// The location of the sender.
+	FromLocation Location
+
+	// The location of the recipient.
+	ToLocation Location
+
+	// The version of the Twilio API used to handle the message.
+	APIVersion string
+}
+
+// VoiceRequest is the payload of the webhooks Twilio sends during a voice call,
+// either when the call connects or in response to a TwiML verb such as
+// <Gather> or <Record>.
+type VoiceRequest struct {
+	// A 34 character string that uniquely identifies the call.
+	CallSID string
+
+	// The unique id of the Account the call belongs to.
+	AccountSID string
+
+	// The phone number, SIP address, or client identifier of the caller.
+	From string
+
+	// The phone number, SIP address, or client identifier that was called.
+	To string
+
+	// The status of the call (e.g., ringing or in-progress).
+	CallStatus twilio.CallStatus
+
+	// The direction of the call. inbound for inbound calls, outbound-api for
+	// calls initiated via the REST API, or outbound-dial for calls initiated
+	// by a <Dial> verb.
+	Direction string
+
+	// The number the call was forwarded from, if the carrier supports it.
+	ForwardedFrom string
+
+	// The name of the caller, if Caller ID Lookup is enabled.
+	CallerName string
+
+	// The SID of the call that spawned this one, if any.
+	ParentCallSID string
+
+	// The digits the caller pressed, in response to a <Gather>.
+	Digits string
+
+	// The transcribed speech of the caller, in response to a <Gather>.
+	SpeechResult string
+
+	// The confidence of SpeechResult, between 0 and 1.
+	Confidence float64
+
+	// The URL of the recording, in response to a <Record>.
+	RecordingURL string
+
+	// The SID of the recording, in response to a <Record>.
+	RecordingSID string
+
+	// The length of the recording in seconds, in response to a <Record>.
+	RecordingDuration int
+
+	// The location of the caller.
+	FromLocation Location
+
+	// The location of the callee.
+	ToLocation Location
+
+	// The version of the Twilio API used to handle the call.
+	APIVersion string
+}
+
+// ParseIncomingMessage parses the form fields of an incoming message webhook.
+func ParseIncomingMessage(r *http.Request) (*IncomingMessage, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	m := &IncomingMessage{
+		MessageSID:          f.Get("MessageSid"),
+		AccountSID:          f.Get("AccountSid"),
+		MessagingServiceSID: f.Get("MessagingServiceSid"),
+		From:                f.Get("From"),
+		To:                  f.Get("To"),
+		Body:                f.Get("Body"),
+		FromLocation:        parseLocation(f, "From"),
+		ToLocation:          parseLocation(f, "To"),
+		APIVersion:          f.Get("ApiVersion"),
+	}
+
+	// older webhooks only include SmsMessageSid
+	if len(m.MessageSID) == 0 {
+		m.MessageSID = f.Get("SmsMessageSid")
+	}
+
+	var err error
+
+	if m.NumSegments, err = formInt(f, "NumSegments"); err != nil {
+		return nil, err
+	}
+
+	if m.NumMedia, err = formInt(f, "NumMedia"); err != nil {
+		return nil, err
+	}
+
+	if m.NumMedia < 0 || m.NumMedia > MaxMedia {
+		return nil, fmt.Errorf("webhook: NumMedia %d outside of range [0,%d]", m.NumMedia, MaxMedia)
+	}
+
+	for i := 0; i < m.NumMedia; i++ {
+		m.Media = append(m.Media, Media{
+			URL:         f.Get(fmt.Sprintf("MediaUrl%d", i)),
+			ContentType: f.Get(fmt.Sprintf("MediaContentType%d", i)),
+		})
+	}
+
+	return m, nil
+}
+
+// ParseVoiceRequest parses the form fields of a voice webhook.
+func ParseVoiceRequest(r *http.Request) (*VoiceRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	v := &VoiceRequest{
+		CallSID:       f.Get("CallSid"),
+		AccountSID:    f.Get("AccountSid"),
+		From:          f.Get("From"),
+		To:            f.Get("To"),
+		CallStatus:    twilio.CallStatus(f.Get("CallStatus")),
+		Direction:     f.Get("Direction"),
+		ForwardedFrom: f.Get("ForwardedFrom"),
+		CallerName:    f.Get("CallerName"),
+		ParentCallSID: f.Get("ParentCallSid"),
+		Digits:        f.Get("Digits"),
+		SpeechResult:  f.Get("SpeechResult"),
+		RecordingURL:  f.Get("RecordingUrl"),
+		RecordingSID:  f.Get("RecordingSid"),
+		FromLocation:  parseLocation(f, "From"),
+		ToLocation:    parseLocation(f, "To"),
+		APIVersion:    f.Get("ApiVersion"),
+	}
+
+	var err error
+
+	if v.RecordingDuration, err = formInt(f, "RecordingDuration"); err != nil {
+		return nil, err
+	}
+
+	if s := f.Get("Confidence"); len(s) > 0 {
+		if v.Confidence, err = strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("webhook: invalid Confidence %q: %s", s, err)
+		}
+	}
+
+	return v, nil
+}
+
+func parseLocation(f url.Values, prefix string) Location {
+	return Location{
+		City:    f.Get(prefix + "City"),
+		State:   f.Get(prefix + "State"),
+		Zip:     f.Get(prefix + "Zip"),
+		Country: f.Get(prefix + "Country"),
+	}
+}
+
+// formInt parses the form field as an int, returning zero if it's empty.
+func formInt(f url.Values, key string) (int, error) {
+	s := f.Get(key)
+
+	if len(s) == 0 {
+		return 0, nil
+	}
+
+	i, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, fmt.Errorf("webhook: invalid %s %q: %s", key, s, err)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/theckman/houston/twilio"
+)
+
+func testPostRequest(v url.Values) *http.Request {
+	r := httptest.NewRequest("POST", "/", strings.NewReader(v.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return r
+}
+
+func TestParseIncomingMessage(t *testing.T) {
+	v := url.Values{}
+	v.Set("MessageSid", "MM1")
+	v.Set("AccountSid", "AC1")
+	v.Set("From", "+15005550001")
+	v.Set("To", "+15005550006")
+	v.Set("Body", "ack")
+	v.Set("NumSegments", "1")
+	v.Set("NumMedia", "2")
+	v.Set("MediaUrl0", "https://api.twilio.com/media/0")
+	v.Set("MediaContentType0", "image/jpeg")
+	v.Set("MediaUrl1", "https://api.twilio.com/media/1")
+	v.Set("MediaContentType1", "image/png")
+	v.Set("FromCity", "SAN FRANCISCO")
+
+	m, err := ParseIncomingMessage(testPostRequest(v))
+
+	if err != nil {
+		t.Fatalf("ParseIncomingMessage() = _, %s; want <nil>", err)
+	}
+
+	if m.MessageSID != "MM1" || m.Body != "ack" || m.NumSegments != 1 {
+		t.Errorf("m = %+v; want MessageSID MM1, Body ack, NumSegments 1", m)
+	}
+
+	if m.FromLocation.City != "SAN FRANCISCO" {
+		t.Errorf("m.FromLocation.City = %q; want %q", m.FromLocation.City, "SAN FRANCISCO")
+	}
+
+	want := []Media{
+		{URL: "https://api.twilio.com/media/0", ContentType: "image/jpeg"},
+		{URL: "https://api.twilio.com/media/1", ContentType: "image/png"},
+	}
+
+	if len(m.Media) != len(want) {
+		t.Fatalf("m.Media = %+v; want %+v", m.Media, want)
+	}
+
+	for i := range want {
+		if m.Media[i] != want[i] {
+			t.Errorf("m.Media[%d] = %+v; want %+v", i, m.Media[i], want[i])
+		}
+	}
+
+	v.Set("NumMedia", "two")
+
+	if _, err = ParseIncomingMessage(testPostRequest(v)); err == nil {
+		t.Error("ParseIncomingMessage() with invalid NumMedia = _, <nil>; want error")
+	}
+
+	for _, n := range []string{"-1", "11", "50000000"} {
+		v.Set("NumMedia", n)
+
+		if _, err = ParseIncomingMessage(testPostRequest(v)); err == nil {
+			t.Errorf("ParseIncomingMessage() with NumMedia %s = _, <nil>; want error", n)
+		}
+	}
+}
+
+func TestParseVoiceRequest(t *testing.T) {
+	v := url.Values{}
+	v.Set("CallSid", "CA1")
+	v.Set("CallStatus", "in-progress")
+	v.Set("Digits", "1")
+	v.Set("SpeechResult", "acknowledge")
+	v.Set("Confidence", "0.92")
+	v.Set("RecordingDuration", "12")
+
+	vr, err := ParseVoiceRequest(testPostRequest(v))
+
+	if err != nil {
+		t.Fatalf("ParseVoiceRequest() = _, %s; want <nil>", err)
+	}
+
+	if vr.CallSID != "CA1" || vr.CallStatus != twilio.CallStatusInProgress || vr.Digits != "1" {
+		t.Errorf("vr = %+v; want CallSID CA1, CallStatus in-progress, Digits 1", vr)
+	}
+
+	if vr.SpeechResult != "acknowledge" || vr.Confidence != 0.92 || vr.RecordingDuration != 12 {
+		t.Errorf("vr = %+v; want SpeechResult acknowledge, Confidence 0.92, RecordingDuration 12", vr)
+	}
+
+	v.Set("Confidence", "high")
+
+	if _, err = ParseVoiceRequest(testPostRequest(v)); err == nil {
+		t.Error("ParseVoiceRequest() with invalid Confidence = _, <nil>; want error")
+	}
+}
@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/theckman/houston/twilio"
+)
+
+// MessageStatusCallback is the payload of the webhook Twilio sends to the
+// StatusCallback of a message when its status changes.
+type MessageStatusCallback struct {
+	// A 34 character string that uniquely identifies the message.
+	MessageSID string
+
+	// The unique id of the Account that sent the message.
+	AccountSID string
+
+	// The unique id of the Messaging Service used to send the message, if
+	// any.
+	MessagingServiceSID string
+
+	// The phone number or sender ID that sent the message.
+	From string
+
+	// The phone number the message was sent to.
+	To string
+
+	// The new status of the message.
+	MessageStatus twilio.MessageStatus
+
+	// The error code explaining why the message failed or was undelivered.
+	// Zero if there was no error.
+	ErrorCode int
+}
+
+// ParseMessageStatusCallback parses the form fields of a message status
+// callback webhook.
+func ParseMessageStatusCallback(r *http.Request) (*MessageStatusCallback, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	f := r.Form
+
+	cb := &MessageStatusCallback{
+		MessageSID:          f.Get("MessageSid"),
+		AccountSID:          f.Get("AccountSid"),
+		MessagingServiceSID: f.Get("MessagingServiceSid"),
+		From:                f.Get("From"),
+		To:                  f.Get("To"),
+		MessageStatus:       twilio.MessageStatus(f.Get("MessageStatus")),
+	}
+
+	// older webhooks only include SmsSid and SmsStatus
+	if len(cb.MessageSID) == 0 {
+		cb.MessageSID = f.Get("SmsSid")
+	}
+
+	if len(cb.MessageStatus) == 0 {
+		cb.MessageStatus = twilio.MessageStatus(f.Get("SmsStatus"))
+	}
+
+	if len(cb.MessageSID) == 0 {
+		return nil, fmt.Errorf("webhook: status callback has no MessageSid")
+	}
+
+	if !cb.MessageStatus.Valid() {
+		return nil, fmt.Errorf("webhook: unknown MessageStatus %q", cb.MessageStatus)
+	}
+
+	var err error
+
+	if cb.ErrorCode, err = formInt(f, "ErrorCode"); err != nil {
+		return nil, err
+	}
+
+	return cb, nil
+}
+
+// MessageStatusHook is a function called for a message status callback.
+type MessageStatusHook func(cb *MessageStatusCallback)
+
+// MessageStatusHandler is an http.Handler for message status callbacks. It
+// tracks the last known status of each message, and only invokes the hooks
+// registered for a status when the message legally transitions to it. This
+// filters out callbacks that arrive late or are sent twice.
+//
+// The status of a message is forgotten if no callback has been received for it
+// within StateTTL. Terminal statuses are remembered too, so that a callback
+// arriving after the message failed or was read is still rejected. The zero
+// value is ready to use, and a MessageStatusHandler is safe for concurrent use.
+type MessageStatusHandler struct {
+	// StateTTL is how long the status of a message is remembered after its
+	// last callback. If zero, DefaultStateTTL is used.
+	StateTTL time.Duration
+
+	// OnInvalidTransition, if non-nil, is called with the previous status of
+	// the message when a callback is received with an illegal transition.
+	OnInvalidTransition func(from twilio.MessageStatus, cb *MessageStatusCallback)
+
+	mu        sync.Mutex
+	hooks     map[twilio.MessageStatus][]MessageStatusHook
+	states    map[string]messageState
+	lastPrune time.Time
+}
+
+type messageState struct {
+	status  twilio.MessageStatus
+	updated time.Time
+}
+
+// DefaultStateTTL is the StateTTL used by a MessageStatusHandler that does not
+// set one.
+const DefaultStateTTL = 72 * time.Hour
+
+// NewMessageStatusHandler returns a new MessageStatusHandler with a StateTTL
+// of DefaultStateTTL.
+func NewMessageStatusHandler() *MessageStatusHandler {
+	return &MessageStatusHandler{StateTTL: DefaultStateTTL}
+}
+
+// On registers a hook to be called when a message transitions to status.
+// Hooks are called in the order they were registered.
+func (h *MessageStatusHandler) On(status twilio.MessageStatus, hook MessageStatusHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.hooks == nil {
+		h.hooks = make(map[twilio.MessageStatus][]MessageStatusHook)
+	}
+
+	h.hooks[status] = append(h.hooks[status], hook)
+}
+
+// ServeHTTP implements the http.Handler interface. Callbacks that can't be
+// parsed get a 400 Bad Request, while all others get a 204 No Content so that
+// Twilio does not retry them.
+func (h *MessageStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cb, err := ParseMessageStatusCallback(r)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.Dispatch(cb)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Dispatch validates the transition of the message to the status in cb and,
+// if it's legal, calls the hooks registered for that status. It returns
+// whether the transition was legal.
+func (h *MessageStatusHandler) Dispatch(cb *MessageStatusCallback) bool {
+	now := time.Now()
+
+	h.mu.Lock()
+
+	h.prune(now)
+
+	prev, seen := h.states[cb.MessageSID]
+
+	if seen && !prev.status.CanTransition(cb.MessageStatus) {
+		invalid := h.OnInvalidTransition
+		h.mu.Unlock()
+
+		if invalid != nil {
+			invalid(prev.status, cb)
+		}
+
+		return false
+	}
+
+	if h.states == nil {
+		h.states = make(map[string]messageState)
+	}
+
+	h.states[cb.MessageSID] = messageState{status: cb.MessageStatus, updated: now}
+
+	hooks := h.hooks[cb.MessageStatus]
+
+	h.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(cb)
+	}
+
+	return true
+}
+
+// prune forgets the status of messages that have not been updated within the
+// StateTTL. To keep callbacks fast, it runs at most once a minute. The caller
+// must hold h.mu.
+func (h *MessageStatusHandler) prune(now time.Time) {
+	if now.Sub(h.lastPrune) < time.Minute {
+		return
+	}
+
+	h.lastPrune = now
+
+	ttl := h.StateTTL
+
+	if ttl <= 0 {
+		ttl = DefaultStateTTL
+	}
+
+	for sid, st := range h.states {
+		if now.Sub(st.updated) > ttl {
+			delete(h.states, sid)
+		}
+	}
+}
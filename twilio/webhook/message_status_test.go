@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/theckman/houston/twilio"
+)
+
+func testMessageStatusRequest(sid string, status twilio.MessageStatus, errorCode string) *http.Request {
+	v := url.Values{}
+	v.Set("MessageSid", sid)
+	v.Set("MessageStatus", string(status))
+
+	if len(errorCode) > 0 {
+		v.Set("ErrorCode", errorCode)
+	}
+
+	return testPostRequest(v)
+}
+
+func TestParseMessageStatusCallback(t *testing.T) {
+	cb, err := ParseMessageStatusCallback(testMessageStatusRequest("SM1", twilio.MessageStatusUndelivered, "30003"))
+
+	if err != nil {
+		t.Fatalf("ParseMessageStatusCallback() = _, %s; want <nil>", err)
+	}
+
+	if cb.MessageSID != "SM1" || cb.MessageStatus != twilio.MessageStatusUndelivered || cb.ErrorCode != 30003 {
+		t.Errorf("cb = %+v; want SM1 undelivered with ErrorCode 30003", cb)
+	}
+
+	if _, err = ParseMessageStatusCallback(testMessageStatusRequest("SM1", "lost", "")); err == nil {
+		t.Error("ParseMessageStatusCallback() with unknown status = _, <nil>; want error")
+	}
+}
+
+func TestMessageStatusHandler(t *testing.T) {
+	h := NewMessageStatusHandler()
+
+	var delivered, failed, invalid []string
+
+	h.On(twilio.MessageStatusDelivered, func(cb *MessageStatusCallback) { delivered = append(delivered, cb.MessageSID) })
+	h.On(twilio.MessageStatusFailed, func(cb *MessageStatusCallback) { failed = append(failed, cb.MessageSID) })
+	h.OnInvalidTransition = func(from twilio.MessageStatus, cb *MessageStatusCallback) {
+		invalid = append(invalid, string(from)+">"+string(cb.MessageStatus))
+	}
+
+	steps := []struct {
+		sid    string
+		status twilio.MessageStatus
+		code   int
+	}{
+		{"SM1", twilio.MessageStatusSent, http.StatusNoContent},
+		{"SM1", twilio.MessageStatusDelivered, http.StatusNoContent},
+		{"SM2", twilio.MessageStatusSent, http.StatusNoContent},
+		{"SM2", twilio.MessageStatusQueued, http.StatusNoContent},
+		{"SM2", twilio.MessageStatusFailed, http.StatusNoContent},
+		{"SM2", twilio.MessageStatusSent, http.StatusNoContent},
+		{"SM3", "bogus", http.StatusBadRequest},
+	}
+
+	for _, s := range steps {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, testMessageStatusRequest(s.sid, s.status, ""))
+
+		if w.Code != s.code {
+			t.Errorf("%s %s: w.Code = %d; want %d", s.sid, s.status, w.Code, s.code)
+		}
+	}
+
+	if len(delivered) != 1 || delivered[0] != "SM1" {
+		t.Errorf("delivered = %v; want [SM1]", delivered)
+	}
+
+	if len(failed) != 1 || failed[0] != "SM2" {
+		t.Errorf("failed = %v; want [SM2]", failed)
+	}
+
+	// the late sent for SM2 must not undo its failure
+	if len(invalid) != 2 || invalid[0] != "sent>queued" || invalid[1] != "failed>sent" {
+		t.Errorf("invalid = %v; want [sent>queued failed>sent]", invalid)
+	}
+
+	if st := h.states["SM2"].status; st != twilio.MessageStatusFailed {
+		t.Errorf("h.states[\"SM2\"].status = %q; want %q", st, twilio.MessageStatusFailed)
+	}
+}
+
+func TestMessageStatusHandler_zeroValue(t *testing.T) {
+	var h MessageStatusHandler
+	var sent int
+
+	h.On(twilio.MessageStatusSent, func(*MessageStatusCallback) { sent++ })
+
+	if !h.Dispatch(&MessageStatusCallback{MessageSID: "SM1", MessageStatus: twilio.MessageStatusSent}) {
+		t.Fatal("h.Dispatch() = false; want true")
+	}
+
+	if sent != 1 {
+		t.Errorf("sent = %d; want 1", sent)
+	}
+
+	if h.Dispatch(&MessageStatusCallback{MessageSID: "SM1", MessageStatus: twilio.MessageStatusQueued}) {
+		t.Error("h.Dispatch() with sent>queued = true; want false")
+	}
+}
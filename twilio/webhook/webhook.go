@@ -0,0 +1,246 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+// Package webhook provides helpers for handling the HTTP requests (webhooks)
+// Twilio makes to your application, such as for inbound messages and calls.
+//
+// Every webhook request is signed by Twilio using the auth token of your
+// account, which is the Secret of a twilio.Client created with your Account
+// SID and Auth Token. A client created with an API key does not hold the auth
+// token, so it must be provided separately. Requests should be validated
+// before being trusted.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SignatureHeader is the HTTP header Twilio uses to send the request
+// signature.
+const SignatureHeader = "X-Twilio-Signature"
+
+var (
+	// ErrMissingSignature is returned when a request does not have an
+	// X-Twilio-Signature header.
+	ErrMissingSignature = errors.New("webhook: request has no X-Twilio-Signature header")
+
+	// ErrInvalidSignature is returned when the X-Twilio-Signature header of a
+	// request does not match the signature computed for it.
+	ErrInvalidSignature = errors.New("webhook: invalid X-Twilio-Signature")
+
+	// ErrBodyHashMismatch is returned when the SHA-256 hash of a JSON request
+	// body does not match the bodySHA256 query parameter.
+	ErrBodyHashMismatch = errors.New("webhook: request body does not match bodySHA256")
+)
+
+// ValidateRequest validates that r was sent by Twilio, by checking its
+// X-Twilio-Signature header against the HMAC-SHA1 of the request signed using
+// authToken.
+//
+// The publicURL is the full URL, including the query string, that Twilio made
+// the request to. This can differ from what the server sees when running
+// behind a proxy or load balancer. If publicURL is empty, it is reconstructed
+// from r.
+//
+// For form-encoded requests, the body is parsed and made available in
+// r.PostForm. For requests with a JSON body (signified by the bodySHA256 query
+// parameter), the body is read to verify its hash and then replaced so it can
+// be read again by the caller.
+func ValidateRequest(authToken string, r *http.Request, publicURL string) error {
+	sig := r.Header.Get(SignatureHeader)
+
+	if len(sig) == 0 {
+		return ErrMissingSignature
+	}
+
+	if len(publicURL) == 0 {
+		publicURL = RequestURL(r)
+	}
+
+	u, err := url.Parse(publicURL)
+
+	if err != nil {
+		return err
+	}
+
+	var params url.Values
+
+	if bodyHash := u.Query().Get("bodySHA256"); len(bodyHash) > 0 {
+		if err = validateBody(r, bodyHash); err != nil {
+			return err
+		}
+	} else if r.Method == "POST" {
+		if err = r.ParseForm(); err != nil {
+			return err
+		}
+
+		params = r.PostForm
+	}
+
+	for _, urlStr := range urlVariants(u) {
+		if hmac.Equal([]byte(sig), []byte(Signature(authToken, urlStr, params))) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// Signature computes the value of the X-Twilio-Signature header for a request
+// to urlStr with the POST params provided. This is the base64 encoded
+// HMAC-SHA1 of the URL with each of the params, sorted by name, appended to
+// it.
+func Signature(authToken, urlStr string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+
+	for k := range params {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+
+	buf.WriteString(urlStr)
+
+	for _, k := range keys {
+		values := append([]string(nil), params[k]...)
+		sort.Strings(values)
+
+		for _, v := range values {
+			buf.WriteString(k)
+			buf.WriteString(v)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write(buf.Bytes())
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// RequestURL reconstructs the URL a request was made to, honoring the
+// X-Forwarded-Proto header set by most proxies.
+func RequestURL(r *http.Request) string {
+	scheme := "http"
+
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	if proto := r.Header.Get("X-Forwarded-Proto"); len(proto) > 0 {
+		scheme = proto
+	}
+
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
+
+// validateBody checks the SHA-256 hash of the request body against bodyHash,
+// and replaces the body so it can be read again.
+func validateBody(r *http.Request, bodyHash string) error {
+	var body []byte
+
+	if r.Body != nil {
+		var err error
+
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			return err
+		}
+
+		r.Body.Close()
+	}
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	sum := sha256.Sum256(body)
+
+	if !hmac.Equal([]byte(hex.EncodeToString(sum[:])), []byte(strings.ToLower(bodyHash))) {
+		return ErrBodyHashMismatch
+	}
+
+	return nil
+}
+
+// urlVariants returns the URL as given, as well as with the default port for
+// its scheme added or removed. Twilio is inconsistent about including the port
+// when signing requests, so both need to be checked.
+func urlVariants(u *url.URL) []string {
+	variants := []string{u.String()}
+
+	var port string
+
+	switch u.Scheme {
+	case "https":
+		port = "443"
+	case "http":
+		port = "80"
+	default:
+		return variants
+	}
+
+	v := *u
+
+	switch u.Port() {
+	case "":
+		v.Host = u.Host + ":" + port
+	case port:
+		v.Host = u.Hostname()
+	default:
+		return variants
+	}
+
+	return append(variants, v.String())
+}
+
+// Validator is an http.Handler middleware that rejects any request that does
+// not have a valid X-Twilio-Signature with a 403 Forbidden. Valid requests are
+// passed to Handler.
+type Validator struct {
+	// AuthToken is the auth token of the Twilio account the webhooks are for.
+	AuthToken string
+
+	// BaseURL, if set, is the public scheme and host (e.g.,
+	// "https://houston.example.org") Twilio uses to reach this server. The
+	// URI of each request is appended to it to get the URL that was signed.
+	// If it is empty, the URL is reconstructed from each request.
+	BaseURL string
+
+	// Handler is the handler called for valid requests.
+	Handler http.Handler
+}
+
+// Validate returns a Validator wrapping h, using authToken to validate
+// requests.
+func Validate(authToken string, h http.Handler) *Validator {
+	return &Validator{AuthToken: authToken, Handler: h}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (v *Validator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var publicURL string
+
+	if len(v.BaseURL) > 0 {
+		publicURL = strings.TrimSuffix(v.BaseURL, "/") + r.URL.RequestURI()
+	}
+
+	if err := ValidateRequest(v.AuthToken, r, publicURL); err != nil {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	v.Handler.ServeHTTP(w, r)
+}
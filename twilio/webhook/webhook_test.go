@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public License,
+// v. 2.0. If a copy of the MPL was not distributed with this file, you can
+// obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright (c) 2017 Tim Heckman
+
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// These values come from the Twilio webhook security documentation.
+const (
+	testAuthToken = "12345"
+	testURL       = "https://mycompany.com/myapp.php?foo=1&bar=2"
+	testSignature = "0/KCTR6DLpKmkAf8muzZqo1nDgQ="
+	testJSONBody  = `{"property": "value", "boolean": true}`
+	testBodyHash  = "0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+	testJSONSig   = "y77kIzt2vzLz71DgmJGsen2scGs="
+)
+
+func testParams() url.Values {
+	v := url.Values{}
+	v.Set("CallSid", "CA1234567890ABCDE")
+	v.Set("Caller", "+12349013030")
+	v.Set("Digits", "1234")
+	v.Set("From", "+12349013030")
+	v.Set("To", "+18005551212")
+
+	return v
+}
+
+func testFormRequest(sig string) *http.Request {
+	r := httptest.NewRequest("POST", "/myapp.php?foo=1&bar=2", strings.NewReader(testParams().Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if len(sig) > 0 {
+		r.Header.Set(SignatureHeader, sig)
+	}
+
+	return r
+}
+
+func TestSignature(t *testing.T) {
+	if sig := Signature(testAuthToken, testURL, testParams()); sig != testSignature {
+		t.Errorf("Signature() = %q; want %q", sig, testSignature)
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		r         *http.Request
+		publicURL string
+		err       error
+		desc      string
+	}{
+		{r: testFormRequest(testSignature), publicURL: testURL, desc: `valid form request`},
+		{r: testFormRequest(testSignature), publicURL: "https://mycompany.com:443/myapp.php?foo=1&bar=2", desc: `the port should be optional`},
+		{r: testFormRequest(""), publicURL: testURL, err: ErrMissingSignature, desc: `unsigned requests should be rejected`},
+		{r: testFormRequest("bm9wZQ=="), publicURL: testURL, err: ErrInvalidSignature, desc: `bad signatures should be rejected`},
+		{r: testFormRequest(testSignature), publicURL: "https://evil.com/myapp.php?foo=1&bar=2", err: ErrInvalidSignature, desc: `the URL should be signed`},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateRequest(testAuthToken, tt.r, tt.publicURL); err != tt.err {
+			t.Errorf("\nDescription: %s\nValidateRequest() = %v; want %v", tt.desc, err, tt.err)
+		}
+	}
+}
+
+func TestValidateRequest_bodySHA256(t *testing.T) {
+	publicURL := "https://mycompany.com/myapp.php?bodySHA256=" + testBodyHash
+
+	r := httptest.NewRequest("POST", "/myapp.php?bodySHA256="+testBodyHash, strings.NewReader(testJSONBody))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(SignatureHeader, testJSONSig)
+
+	if err := ValidateRequest(testAuthToken, r, publicURL); err != nil {
+		t.Fatalf("ValidateRequest() = %s; want <nil>", err)
+	}
+
+	// the body should still be readable by the handler
+	if body, _ := ioutil.ReadAll(r.Body); string(body) != testJSONBody {
+		t.Errorf("r.Body = %q; want %q", body, testJSONBody)
+	}
+
+	r = httptest.NewRequest("POST", "/myapp.php?bodySHA256="+testBodyHash, strings.NewReader(`{"tampered": true}`))
+	r.Header.Set(SignatureHeader, testJSONSig)
+
+	if err := ValidateRequest(testAuthToken, r, publicURL); err != ErrBodyHashMismatch {
+		t.Errorf("ValidateRequest() with tampered body = %v; want %v", err, ErrBodyHashMismatch)
+	}
+}
+
+func TestValidator(t *testing.T) {
+	var called bool
+
+	v := Validate(testAuthToken, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+
+		if r.PostForm.Get("Digits") != "1234" {
+			t.Errorf("r.PostForm.Get(\"Digits\") = %q; want \"1234\"", r.PostForm.Get("Digits"))
+		}
+	}))
+
+	v.BaseURL = "https://mycompany.com/"
+
+	w := httptest.NewRecorder()
+	v.ServeHTTP(w, testFormRequest(testSignature))
+
+	if !called || w.Code != http.StatusOK {
+		t.Errorf("valid request: called = %t, w.Code = %d; want true, 200", called, w.Code)
+	}
+
+	called = false
+	w = httptest.NewRecorder()
+	v.ServeHTTP(w, testFormRequest(""))
+
+	if called || w.Code != http.StatusForbidden {
+		t.Errorf("unsigned request: called = %t, w.Code = %d; want false, 403", called, w.Code)
+	}
+}